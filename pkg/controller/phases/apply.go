@@ -0,0 +1,421 @@
+// SPDX-License-Identifier: MIT
+
+package phases
+
+import (
+	"errors"
+	"fmt"
+	"strings"
+
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	"github.com/nukleros/operator-builder-tools/pkg/controller/workload"
+	"github.com/nukleros/operator-builder-tools/pkg/resources"
+	"github.com/nukleros/operator-builder-tools/pkg/status"
+)
+
+// DependsOnAnnotation is the annotation which explicitly declares that a child resource must be
+// applied after other child resources when applying resources in parallel.  It accepts a comma-separated
+// list of references in the format of <kind>/<name> or <kind>/<namespace>/<name>.  When the namespace
+// is omitted, the reference matches a resource in the namespace of the annotated resource or a cluster-scoped
+// resource.  A resource is only applied once the resources it depends on are ready.
+const DependsOnAnnotation = "operator-builder.nukleros.io/depends-on"
+
+// parallelApplyWorkers is the maximum number of child resources which are applied concurrently.
+const parallelApplyWorkers = 10
+
+var (
+	ErrInvalidDependency = errors.New("invalid dependency")
+	ErrDependencyCycle   = errors.New("dependency cycle detected")
+)
+
+// applyTier is the default order in which a kind of resource is applied.  Resources are always
+// applied after the resources of all lower tiers.
+type applyTier int
+
+const (
+	applyTierDefinitions applyTier = iota
+	applyTierConfiguration
+	applyTierWorkloads
+	applyTierAdmission
+)
+
+// applyNode is a single child resource in the apply graph.  The dependencies of a node include both the
+// implicit dependencies on the resources of the nearest lower apply tier and the explicit dependencies from
+// its DependsOnAnnotation, which are also recorded separately as they must be ready before it is applied.
+type applyNode struct {
+	resource     client.Object
+	dependencies []int
+	dependents   []int
+	explicit     []int
+}
+
+// applyResult is the outcome of applying a single child resource.
+type applyResult struct {
+	condition status.ChildResourceCondition
+	ready     bool
+	err       error
+}
+
+// createResourcesInParallel creates or updates the desired resources of a workload concurrently in the order
+// of the apply graph.  The resource conditions of all resources are written to the workload status once all
+// resources have been applied.
+func createResourcesInParallel(
+	r workload.Reconciler,
+	req *workload.Request,
+	desiredResources []client.Object,
+	options ...ResourceOption,
+) (bool, error) {
+	graph, err := newApplyGraph(desiredResources)
+	if err != nil {
+		return false, fmt.Errorf("unable to determine resource apply order, %w", err)
+	}
+
+	results := applyGraph(r, req, graph, options...)
+
+	proceed := true
+
+	var resourceErrs []error
+
+	for i, result := range results {
+		if result.err != nil && !IsOptimisticLockError(result.err) {
+			req.Log.Error(result.err, "unable to create or update resource", resources.MessageFor(graph[i].resource)...)
+
+			resourceErrs = append(resourceErrs, result.err)
+		}
+
+		if !result.ready {
+			r.GetLogger().Info("resource is not ready", resources.MessageFor(graph[i].resource)...)
+		}
+
+		proceed = proceed && result.ready
+
+		// the status is not persisted when planning
+		if req.Plan != nil {
+			continue
+		}
+
+		resourceObject := status.ToCommonResource(graph[i].resource)
+		resourceObject.ChildResourceCondition = result.condition
+
+		req.Workload.SetChildResourceCondition(resourceObject)
+	}
+
+	if req.Plan != nil {
+		return proceed, errors.Join(resourceErrs...)
+	}
+
+	// update the status conditions once for all resources
+	if err := r.Status().Update(req.Context, req.Workload); err != nil {
+		if !IsOptimisticLockError(err) {
+			r.GetLogger().Error(err, "failed to update resource conditions")
+
+			proceed = false
+		}
+	}
+
+	return proceed, errors.Join(resourceErrs...)
+}
+
+// applyGraph applies each resource in the graph once all of its dependencies have been applied, using a
+// bounded pool of workers.  A resource is only applied once its explicit dependencies are ready, or all of its
+// dependencies when requested with ResourceOptionWithWait.  Resources with a dependency which is not ready
+// are not applied and are returned as pending.
+func applyGraph(r workload.Reconciler, req *workload.Request, graph []*applyNode, options ...ResourceOption) []applyResult {
+	wait := hasResourceOption(ResourceOptionWithWait, options...)
+	results := make([]applyResult, len(graph))
+	remaining := make([]int, len(graph))
+	finished := make(chan int)
+	workers := make(chan struct{}, parallelApplyWorkers)
+
+	start := func(i int) {
+		go func() {
+			workers <- struct{}{}
+
+			condition, ready, err := persistResource(r, req, graph[i].resource, options...)
+
+			<-workers
+
+			results[i] = applyResult{condition: condition, ready: ready, err: err}
+			finished <- i
+		}()
+	}
+
+	var inFlight int
+
+	for i := range graph {
+		remaining[i] = len(graph[i].dependencies)
+
+		if remaining[i] == 0 {
+			start(i)
+
+			inFlight++
+		}
+	}
+
+	for inFlight > 0 {
+		completed := []int{<-finished}
+		inFlight--
+
+		// release the dependents of each completed resource, marking dependents of resources
+		// which are not ready as pending so that their own dependents are released as well
+		for len(completed) > 0 {
+			current := completed[0]
+			completed = completed[1:]
+
+			for _, dependent := range graph[current].dependents {
+				remaining[dependent]--
+				if remaining[dependent] > 0 {
+					continue
+				}
+
+				if dependenciesReady(graph[dependent], results, wait) {
+					start(dependent)
+
+					inFlight++
+
+					continue
+				}
+
+				results[dependent] = applyResult{condition: status.GetPendingResourceCondition()}
+				completed = append(completed, dependent)
+			}
+		}
+	}
+
+	return results
+}
+
+// dependenciesReady determines if the dependencies of a node which gate its apply were applied and are ready.
+// The implicit dependencies on lower apply tiers only order the apply unless waiting on all resources.
+func dependenciesReady(node *applyNode, results []applyResult, wait bool) bool {
+	dependencies := node.explicit
+	if wait {
+		dependencies = node.dependencies
+	}
+
+	for _, dependency := range dependencies {
+		if !results[dependency].ready {
+			return false
+		}
+	}
+
+	return true
+}
+
+// newApplyGraph returns the graph of desired resources.  Each resource depends on the resources of the
+// nearest lower apply tier as well as the resources referenced in its DependsOnAnnotation.
+func newApplyGraph(desiredResources []client.Object) ([]*applyNode, error) {
+	graph := newTierGraph(desiredResources)
+
+	if err := addExplicitDependencies(graph); err != nil {
+		return nil, err
+	}
+
+	if _, err := sortApplyGraph(graph); err != nil {
+		return nil, err
+	}
+
+	return graph, nil
+}
+
+// newTierGraph returns the graph of desired resources where each resource only depends on the resources
+// of the nearest lower apply tier.
+func newTierGraph(desiredResources []client.Object) []*applyNode {
+	graph := make([]*applyNode, len(desiredResources))
+	tiers := map[applyTier][]int{}
+
+	for i, resource := range desiredResources {
+		graph[i] = &applyNode{resource: resource}
+
+		tier := applyTierFor(resource)
+		tiers[tier] = append(tiers[tier], i)
+	}
+
+	for i, resource := range desiredResources {
+		for tier := applyTierFor(resource) - 1; tier >= applyTierDefinitions; tier-- {
+			if len(tiers[tier]) == 0 {
+				continue
+			}
+
+			for _, dependency := range tiers[tier] {
+				addDependency(graph, i, dependency)
+			}
+
+			break
+		}
+	}
+
+	return graph
+}
+
+// addExplicitDependencies adds the dependencies from the DependsOnAnnotation of each resource to the
+// graph.  Invalid references are not added and are returned together as an error.
+func addExplicitDependencies(graph []*applyNode) error {
+	desiredResources := make([]client.Object, len(graph))
+	for i := range graph {
+		desiredResources[i] = graph[i].resource
+	}
+
+	var errs []error
+
+	for i, resource := range desiredResources {
+		explicit, err := explicitDependencies(desiredResources, resource)
+		if err != nil {
+			errs = append(errs, err)
+		}
+
+		for _, dependency := range explicit {
+			if dependency == i {
+				errs = append(errs, fmt.Errorf("%w; resource %s depends on itself", ErrDependencyCycle, resource.GetName()))
+
+				continue
+			}
+
+			addDependency(graph, i, dependency)
+			graph[i].explicit = append(graph[i].explicit, dependency)
+		}
+	}
+
+	return errors.Join(errs...)
+}
+
+// addDependency adds a dependency between two nodes of the graph unless it already exists.
+func addDependency(graph []*applyNode, node, dependency int) {
+	for _, existing := range graph[node].dependencies {
+		if existing == dependency {
+			return
+		}
+	}
+
+	graph[node].dependencies = append(graph[node].dependencies, dependency)
+	graph[dependency].dependents = append(graph[dependency].dependents, node)
+}
+
+// sortApplyGraph returns the indexes of the resources in the graph in an order where each resource follows
+// all of its dependencies.  Resources which do not depend on each other keep the order of the desired
+// resources.  It ensures that the graph contains no cycles, which would prevent resources from ever being
+// applied.
+func sortApplyGraph(graph []*applyNode) ([]int, error) {
+	order := make([]int, 0, len(graph))
+	remaining := make([]int, len(graph))
+	queue := []int{}
+
+	for i := range graph {
+		remaining[i] = len(graph[i].dependencies)
+
+		if remaining[i] == 0 {
+			queue = append(queue, i)
+		}
+	}
+
+	for len(queue) > 0 {
+		current := queue[0]
+		queue = queue[1:]
+		order = append(order, current)
+
+		for _, dependent := range graph[current].dependents {
+			remaining[dependent]--
+
+			if remaining[dependent] == 0 {
+				queue = append(queue, dependent)
+			}
+		}
+	}
+
+	if len(order) != len(graph) {
+		cyclic := []string{}
+
+		for i := range graph {
+			if remaining[i] > 0 {
+				cyclic = append(cyclic, fmt.Sprintf("%s/%s", graph[i].resource.GetObjectKind().GroupVersionKind().Kind, graph[i].resource.GetName()))
+			}
+		}
+
+		return nil, fmt.Errorf("%w; between resources [%s]", ErrDependencyCycle, strings.Join(cyclic, ", "))
+	}
+
+	return order, nil
+}
+
+// explicitDependencies returns the indexes of the desired resources which are referenced in the
+// DependsOnAnnotation of a resource.  Invalid references are skipped and returned together as an error.
+func explicitDependencies(desiredResources []client.Object, resource client.Object) ([]int, error) {
+	annotation := resource.GetAnnotations()[DependsOnAnnotation]
+	if annotation == "" {
+		return nil, nil
+	}
+
+	dependencies := []int{}
+
+	var errs []error
+
+	for _, reference := range strings.Split(annotation, ",") {
+		reference = strings.TrimSpace(reference)
+		if reference == "" {
+			continue
+		}
+
+		var kind, namespace, name string
+
+		// a reference without a namespace may also refer to a cluster-scoped resource
+		var clusterScoped bool
+
+		switch parts := strings.Split(reference, "/"); len(parts) {
+		case 2:
+			kind, namespace, name, clusterScoped = parts[0], resource.GetNamespace(), parts[1], true
+		case 3:
+			kind, namespace, name = parts[0], parts[1], parts[2]
+		default:
+			errs = append(errs, fmt.Errorf("%w; reference [%s] on resource %s must be in the format of <kind>/<name> or <kind>/<namespace>/<name>",
+				ErrInvalidDependency, reference, resource.GetName()))
+
+			continue
+		}
+
+		index := findResource(desiredResources, kind, namespace, name, clusterScoped)
+		if index < 0 {
+			errs = append(errs, fmt.Errorf("%w; reference [%s] on resource %s is not a desired resource",
+				ErrInvalidDependency, reference, resource.GetName()))
+
+			continue
+		}
+
+		dependencies = append(dependencies, index)
+	}
+
+	return dependencies, errors.Join(errs...)
+}
+
+// findResource returns the index of a desired resource by kind, namespace and name.  Cluster-scoped
+// resources are only matched when requested, such as when the reference omitted the namespace.
+func findResource(desiredResources []client.Object, kind, namespace, name string, clusterScoped bool) int {
+	for i, desired := range desiredResources {
+		if desired.GetObjectKind().GroupVersionKind().Kind != kind || desired.GetName() != name {
+			continue
+		}
+
+		if desired.GetNamespace() == namespace || (clusterScoped && desired.GetNamespace() == "") {
+			return i
+		}
+	}
+
+	return -1
+}
+
+// applyTierFor returns the default apply tier for a resource based on its kind.
+func applyTierFor(resource client.Object) applyTier {
+	switch resource.GetObjectKind().GroupVersionKind().Kind {
+	case resources.NamespaceKind, resources.CustomResourceDefinitionKind:
+		return applyTierDefinitions
+	case "ServiceAccount", "ClusterRole", "ClusterRoleBinding", "Role", "RoleBinding",
+		"PriorityClass", "StorageClass", "ResourceQuota", "LimitRange", "NetworkPolicy",
+		"PersistentVolume", "PersistentVolumeClaim",
+		resources.SecretKind, resources.ConfigMapKind:
+		return applyTierConfiguration
+	case resources.MutatingWebhookConfigurationKind, resources.ValidatingWebhookConfigurationKind:
+		return applyTierAdmission
+	default:
+		return applyTierWorkloads
+	}
+}
@@ -0,0 +1,227 @@
+/*
+	SPDX-License-Identifier: MIT
+*/
+
+package phases
+
+import (
+	"errors"
+	"reflect"
+	"testing"
+
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+)
+
+func newGraphResource(kind, name, dependsOn string) client.Object {
+	resource := &unstructured.Unstructured{}
+	resource.SetAPIVersion("v1")
+	resource.SetKind(kind)
+	resource.SetName(name)
+	resource.SetNamespace("default")
+
+	if dependsOn != "" {
+		resource.SetAnnotations(map[string]string{DependsOnAnnotation: dependsOn})
+	}
+
+	return resource
+}
+
+func newClusterGraphResource(kind, name string) client.Object {
+	resource := newGraphResource(kind, name, "")
+	resource.SetNamespace("")
+
+	return resource
+}
+
+func Test_newApplyGraph(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name      string
+		resources []client.Object
+		wantOrder []string
+		wantErr   error
+	}{
+		{
+			name: "resources of the same tier keep their order",
+			resources: []client.Object{
+				newGraphResource("Deployment", "b", ""),
+				newGraphResource("Service", "a", ""),
+			},
+			wantOrder: []string{"b", "a"},
+		},
+		{
+			name: "resources are ordered by tier",
+			resources: []client.Object{
+				newGraphResource("ValidatingWebhookConfiguration", "webhook", ""),
+				newGraphResource("Deployment", "deployment", ""),
+				newGraphResource("ConfigMap", "config", ""),
+				newGraphResource("Namespace", "namespace", ""),
+			},
+			wantOrder: []string{"namespace", "config", "deployment", "webhook"},
+		},
+		{
+			name: "explicit dependencies are ordered first",
+			resources: []client.Object{
+				newGraphResource("Deployment", "app", "Service/database"),
+				newGraphResource("Service", "database", ""),
+			},
+			wantOrder: []string{"database", "app"},
+		},
+		{
+			name: "explicit dependencies may reference a namespace",
+			resources: []client.Object{
+				newGraphResource("Deployment", "app", "Service/default/database"),
+				newGraphResource("Service", "database", ""),
+			},
+			wantOrder: []string{"database", "app"},
+		},
+		{
+			name: "explicit dependencies without a namespace may reference a cluster-scoped resource",
+			resources: []client.Object{
+				newGraphResource("Deployment", "app", "ClusterRole/reader"),
+				newClusterGraphResource("ClusterRole", "reader"),
+			},
+			wantOrder: []string{"reader", "app"},
+		},
+		{
+			name: "explicit dependencies in another namespace do not match a resource of the same name",
+			resources: []client.Object{
+				newGraphResource("Deployment", "app", "Service/other/database"),
+				newGraphResource("Service", "database", ""),
+			},
+			wantErr: ErrInvalidDependency,
+		},
+		{
+			name: "explicit dependencies with a namespace do not match a cluster-scoped resource",
+			resources: []client.Object{
+				newGraphResource("Deployment", "app", "ClusterRole/default/reader"),
+				newClusterGraphResource("ClusterRole", "reader"),
+			},
+			wantErr: ErrInvalidDependency,
+		},
+		{
+			name: "resource which depends on itself is a cycle",
+			resources: []client.Object{
+				newGraphResource("Deployment", "app", "Deployment/app"),
+			},
+			wantErr: ErrDependencyCycle,
+		},
+		{
+			name: "resources which depend on each other are a cycle",
+			resources: []client.Object{
+				newGraphResource("Deployment", "a", "Deployment/b"),
+				newGraphResource("Deployment", "b", "Deployment/c"),
+				newGraphResource("Deployment", "c", "Deployment/a"),
+			},
+			wantErr: ErrDependencyCycle,
+		},
+		{
+			name: "explicit dependency against the tier order is a cycle",
+			resources: []client.Object{
+				newGraphResource("ConfigMap", "config", "Deployment/app"),
+				newGraphResource("Deployment", "app", ""),
+			},
+			wantErr: ErrDependencyCycle,
+		},
+		{
+			name: "reference which is not a desired resource is invalid",
+			resources: []client.Object{
+				newGraphResource("Deployment", "app", "Service/missing"),
+			},
+			wantErr: ErrInvalidDependency,
+		},
+		{
+			name: "reference in the wrong format is invalid",
+			resources: []client.Object{
+				newGraphResource("Deployment", "app", "database"),
+			},
+			wantErr: ErrInvalidDependency,
+		},
+	}
+
+	for _, tt := range tests {
+		tt := tt
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			graph, err := newApplyGraph(tt.resources)
+			if tt.wantErr != nil {
+				if !errors.Is(err, tt.wantErr) {
+					t.Errorf("newApplyGraph() error = %v, wantErr %v", err, tt.wantErr)
+				}
+
+				return
+			}
+
+			if err != nil {
+				t.Fatalf("newApplyGraph() error = %v", err)
+			}
+
+			order, err := sortApplyGraph(graph)
+			if err != nil {
+				t.Fatalf("sortApplyGraph() error = %v", err)
+			}
+
+			got := []string{}
+			for _, index := range order {
+				got = append(got, graph[index].resource.GetName())
+			}
+
+			if !reflect.DeepEqual(got, tt.wantOrder) {
+				t.Errorf("sortApplyGraph() = %v, want %v", got, tt.wantOrder)
+			}
+		})
+	}
+}
+
+func Test_dependenciesReady(t *testing.T) {
+	t.Parallel()
+
+	// the config map is applied but not ready, and the deployment depends on it by tier and on the
+	// service explicitly
+	graph, err := newApplyGraph([]client.Object{
+		newGraphResource("ConfigMap", "config", ""),
+		newGraphResource("Service", "database", ""),
+		newGraphResource("Deployment", "app", "Service/database"),
+	})
+	if err != nil {
+		t.Fatalf("newApplyGraph() error = %v", err)
+	}
+
+	tests := []struct {
+		name    string
+		results []applyResult
+		wait    bool
+		want    bool
+	}{
+		{
+			name:    "lower tier which is not ready does not gate the apply",
+			results: []applyResult{{ready: false}, {ready: true}, {}},
+			want:    true,
+		},
+		{
+			name:    "lower tier which is not ready gates the apply when waiting",
+			results: []applyResult{{ready: false}, {ready: true}, {}},
+			wait:    true,
+			want:    false,
+		},
+		{
+			name:    "explicit dependency which is not ready gates the apply",
+			results: []applyResult{{ready: true}, {ready: false}, {}},
+			want:    false,
+		},
+	}
+
+	for _, tt := range tests {
+		tt := tt
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			if got := dependenciesReady(graph[2], tt.results, tt.wait); got != tt.want {
+				t.Errorf("dependenciesReady() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
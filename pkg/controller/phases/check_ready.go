@@ -3,18 +3,21 @@
 package phases
 
 import (
+	"errors"
 	"fmt"
+	"strings"
 
 	"sigs.k8s.io/controller-runtime/pkg/client"
 
 	"github.com/nukleros/operator-builder-tools/pkg/controller/workload"
 	"github.com/nukleros/operator-builder-tools/pkg/resources"
+	"github.com/nukleros/operator-builder-tools/pkg/status"
 )
 
 // CheckReadyPhase executes checking for a parent component's readiness status.
 func CheckReadyPhase(r workload.Reconciler, req *workload.Request, options ...ResourceOption) (bool, error) {
 	// check to see if known types are ready
-	knownReady, err := resourcesAreReady(r, req)
+	knownReady, err := resourcesAreReady(r, req, options...)
 	if err != nil {
 		return false, fmt.Errorf("unable to determine if resources are ready, %w", err)
 	}
@@ -29,26 +32,99 @@ func CheckReadyPhase(r workload.Reconciler, req *workload.Request, options ...Re
 }
 
 // resourcesAreReady gets the resources in memory, pulls the current state from the
-// clusters and determines if they are in a ready condition.
-func resourcesAreReady(r workload.Reconciler, req *workload.Request) (bool, error) {
+// clusters and determines if they are in a ready condition.  The readiness of each resource
+// is recorded in its resource condition.
+func resourcesAreReady(r workload.Reconciler, req *workload.Request, options ...ResourceOption) (bool, error) {
 	// get resources in memory
-	desiredResources, err := r.GetResources(req)
+	desiredResources, err := workload.GetDesiredResources(r, req)
 	if err != nil {
 		return false, fmt.Errorf("unable to retrieve resources, %w", err)
 	}
 
-	// get resources from cluster
-	clusterResources := make([]client.Object, len(desiredResources))
+	ready, changed := true, false
+	failures := []error{}
 
-	for i, rsrc := range desiredResources {
-		clusterResource, err := resources.Get(r, req, rsrc)
+	// get resources from cluster and check to see if known types are ready
+	for _, rsrc := range desiredResources {
+		readiness, err := resources.GetReadinessFromReconciler(r, req, rsrc, readyOptions(options...)...)
 		if err != nil {
-			return false, fmt.Errorf("unable to retrieve resource %s, %w", rsrc.GetName(), err)
+			return false, fmt.Errorf("unable to determine readiness of resource %s, %w", rsrc.GetName(), err)
 		}
 
-		clusterResources[i] = clusterResource
+		if req.Plan == nil {
+			registerDiagnosis(r, req, rsrc, readiness)
+		}
+
+		req.RequestRequeueAfter(readiness.RequeueAfter)
+
+		changed = setReadinessCondition(req, rsrc, readiness) || changed
+		ready = ready && readiness.IsReady()
+
+		if err := readiness.Err(); err != nil {
+			failures = append(failures, err)
+		}
 	}
 
-	// check to see if known types are ready
-	return resources.AreReady(clusterResources...)
+	// update the status conditions only when the readiness of a resource has changed
+	if changed && req.Plan == nil {
+		if err := r.Status().Update(req.Context, req.Workload); err != nil {
+			if !IsOptimisticLockError(err) {
+				return false, fmt.Errorf("unable to update resource conditions, %w", err)
+			}
+		}
+	}
+
+	return ready, errors.Join(failures...)
+}
+
+// registerDiagnosis registers a warning event against the parent workload when a resource has been diagnosed
+// with a problem which differs from the diagnosis of its current resource condition.  The diagnosis is the
+// last part of the message of a resource condition, so only that part is compared, as the rest of the message
+// changes along with the status of the resource, such as its number of ready replicas.  It must be called
+// before the resource condition is updated with the readiness.
+func registerDiagnosis(r workload.Reconciler, req *workload.Request, resource client.Object, readiness *resources.Readiness) {
+	if readiness.Diagnosis == "" {
+		return
+	}
+
+	if existing := findResourceCondition(req, resource); existing != nil && strings.HasSuffix(existing.Message, readiness.Diagnosis) {
+		return
+	}
+
+	status.Unhealthy.RegisterMessage(r.GetEventRecorder(), resource, req.Workload, readiness.Diagnosis)
+}
+
+// findResourceCondition returns the current resource condition of a resource, or nil if the resource does
+// not have a resource condition.
+func findResourceCondition(req *workload.Request, resource client.Object) *status.ChildResource {
+	child := status.ToCommonResource(resource)
+
+	for _, existing := range req.Workload.GetChildResourceConditions() {
+		if existing.Group == child.Group &&
+			existing.Kind == child.Kind &&
+			existing.Name == child.Name &&
+			existing.Namespace == child.Namespace {
+			return existing
+		}
+	}
+
+	return nil
+}
+
+// setReadinessCondition sets the readiness of a resource on its resource condition.  It returns whether
+// the condition has changed.
+func setReadinessCondition(req *workload.Request, resource client.Object, readiness *resources.Readiness) bool {
+	if existing := findResourceCondition(req, resource); existing != nil &&
+		existing.Created &&
+		existing.State == readiness.State &&
+		existing.Reason == readiness.Reason &&
+		existing.Message == readiness.Message {
+		return false
+	}
+
+	child := status.ToCommonResource(resource)
+	child.ChildResourceCondition = readiness.ToResourceCondition()
+	req.Workload.SetChildResourceCondition(child)
+
+	return true
 }
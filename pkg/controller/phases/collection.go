@@ -0,0 +1,143 @@
+// SPDX-License-Identifier: MIT
+
+package phases
+
+import (
+	"fmt"
+
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/types"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	"github.com/nukleros/operator-builder-tools/pkg/controller/reconcile"
+	"github.com/nukleros/operator-builder-tools/pkg/controller/workload"
+	"github.com/nukleros/operator-builder-tools/pkg/resources"
+)
+
+// CollectionPhase resolves the collection of a component workload and stores it on the request.  The
+// workload must implement the workload.CollectionMember interface, otherwise the phase is skipped.  When
+// the collection cannot be found, the phase is left pending until the collection exists.  Changes to
+// the collection requeue each of the components which belong to it, except when planning, as planning
+// must not register watches on the controller.
+func CollectionPhase(r workload.Reconciler, req *workload.Request, options ...ResourceOption) (bool, error) {
+	member, ok := req.Workload.(workload.CollectionMember)
+	if !ok {
+		return true, nil
+	}
+
+	reference := member.GetCollectionReference()
+	if reference == nil {
+		return true, nil
+	}
+
+	// watch the collection prior to resolving it so that components are requeued once a
+	// missing collection is created
+	if req.Plan == nil {
+		if err := reconcile.WatchCollection(r, req, reference); err != nil {
+			return false, fmt.Errorf("unable to watch collection, %w", err)
+		}
+	}
+
+	collection, err := GetCollection(r, req, reference)
+	if err != nil {
+		return false, err
+	}
+
+	req.Collection = collection
+
+	return true, nil
+}
+
+// GetCollection returns the collection workload which is referenced by a collection reference.  It
+// returns a workload.ErrCollectionNotFound error when no collection matches the reference.
+func GetCollection(
+	r workload.Reconciler,
+	req *workload.Request,
+	reference *workload.CollectionReference,
+) (workload.Workload, error) {
+	name := types.NamespacedName{Name: reference.Name, Namespace: reference.Namespace}
+
+	// find the name of the collection by selector when a name was not provided
+	if reference.Name == "" {
+		var err error
+
+		name, err = findCollection(r, req, reference)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	object, err := r.Scheme().New(reference.GroupVersionKind)
+	if err != nil {
+		return nil, fmt.Errorf("unable to create collection of kind %s, %w", reference.GroupVersionKind.Kind, err)
+	}
+
+	collection, ok := object.(workload.Workload)
+	if !ok {
+		return nil, fmt.Errorf("%w; kind %s does not implement a workload", workload.ErrInvalidWorkload, reference.GroupVersionKind.Kind)
+	}
+
+	collectionStore, err := resources.Get(r, req, stubFor(reference, name))
+	if err != nil {
+		return nil, fmt.Errorf("unable to retrieve collection, %w", err)
+	}
+
+	if collectionStore == nil {
+		return nil, fmt.Errorf("%w; %s %s", workload.ErrCollectionNotFound, reference.GroupVersionKind.Kind, name)
+	}
+
+	if err := resources.ToTyped(collection, collectionStore); err != nil {
+		return nil, fmt.Errorf("unable to convert collection, %w", err)
+	}
+
+	return collection, nil
+}
+
+// findCollection finds the name of the single collection which matches the namespace and selector
+// of a collection reference.
+func findCollection(
+	r workload.Reconciler,
+	req *workload.Request,
+	reference *workload.CollectionReference,
+) (types.NamespacedName, error) {
+	collectionList := &unstructured.UnstructuredList{}
+	collectionList.SetGroupVersionKind(reference.GroupVersionKind)
+
+	if err := r.List(req.Context, collectionList, client.InNamespace(reference.Namespace)); err != nil {
+		return types.NamespacedName{}, fmt.Errorf("unable to list collections, %w", err)
+	}
+
+	matches := []types.NamespacedName{}
+
+	for i := range collectionList.Items {
+		match, err := reference.Matches(&collectionList.Items[i])
+		if err != nil {
+			return types.NamespacedName{}, err
+		}
+
+		if match {
+			matches = append(matches, client.ObjectKeyFromObject(&collectionList.Items[i]))
+		}
+	}
+
+	switch len(matches) {
+	case 0:
+		return types.NamespacedName{}, fmt.Errorf("%w; no %s matches the collection reference",
+			workload.ErrCollectionNotFound, reference.GroupVersionKind.Kind)
+	case 1:
+		return matches[0], nil
+	default:
+		return types.NamespacedName{}, fmt.Errorf("expected a single %s to match the collection reference, found %d",
+			reference.GroupVersionKind.Kind, len(matches))
+	}
+}
+
+// stubFor returns an object stub which can be used to look up a collection.
+func stubFor(reference *workload.CollectionReference, name types.NamespacedName) *unstructured.Unstructured {
+	stub := &unstructured.Unstructured{}
+	stub.SetGroupVersionKind(reference.GroupVersionKind)
+	stub.SetName(name.Name)
+	stub.SetNamespace(name.Namespace)
+
+	return stub
+}
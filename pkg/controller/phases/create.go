@@ -3,6 +3,7 @@
 package phases
 
 import (
+	"errors"
 	"fmt"
 
 	ctrl "sigs.k8s.io/controller-runtime"
@@ -17,31 +18,27 @@ import (
 // CreateResourcesPhase creates or updated the child resources of a workload during a reconciliation loop.
 func CreateResourcesPhase(r workload.Reconciler, req *workload.Request, options ...ResourceOption) (bool, error) {
 	// get the resources in memory
-	desiredResources, err := r.GetResources(req)
+	desiredResources, err := workload.GetDesiredResources(r, req)
 	if err != nil {
 		return false, fmt.Errorf("unable to retrieve resources, %w", err)
 	}
 
+	if hasResourceOption(ResourceOptionWithParallelApply, options...) {
+		return createResourcesInParallel(r, req, desiredResources, options...)
+	}
+
 	proceed := true
 
 	wait := hasResourceOption(ResourceOptionWithWait, options...)
 
 	for _, resource := range desiredResources {
-		condition, ready, err := HandleResourcePhaseExit(
-			persistResourcePhase(r, req, resource, wait),
-		)
+		condition, ready, err := persistResource(r, req, resource, options...)
 		if err != nil {
 			if !IsOptimisticLockError(err) {
 				req.Log.Error(err, "unable to create or update resource")
 			}
 		}
 
-		if wait && !ready {
-			r.GetLogger().Info("resource is not ready", resources.MessageFor(resource)...)
-
-			return false, nil
-		}
-
 		resourceObject := status.ToCommonResource(resource)
 		resourceObject.ChildResourceCondition = condition
 
@@ -54,6 +51,17 @@ func CreateResourcesPhase(r workload.Reconciler, req *workload.Request, options
 			}
 		}
 
+		if wait && !ready {
+			// a resource which has failed will not become ready by waiting for it
+			if err != nil && IsTerminalError(err) {
+				return false, err
+			}
+
+			r.GetLogger().Info("resource is not ready", resources.MessageFor(resource)...)
+
+			return false, nil
+		}
+
 		proceed = proceed && ready
 	}
 
@@ -66,6 +74,11 @@ func UpdateResourceConditions(
 	req *workload.Request,
 	resource *status.ChildResource,
 ) error {
+	// the status is not persisted when planning
+	if req.Plan != nil {
+		return nil
+	}
+
 	req.Workload.SetChildResourceCondition(resource)
 
 	if err := r.Status().Update(req.Context, req.Workload); err != nil {
@@ -81,6 +94,10 @@ func HandleResourcePhaseExit(
 	resourceErr error,
 ) (status.ChildResourceCondition, bool, error) {
 	if resourceErr != nil {
+		if errors.Is(resourceErr, resources.ErrFieldOwnershipConflict) {
+			return status.GetConflictResourceCondition(resourceErr), false, resourceErr
+		}
+
 		if !IsOptimisticLockError(resourceErr) {
 			return status.GetFailResourceCondition(resourceErr), false, resourceErr
 		}
@@ -93,13 +110,61 @@ func HandleResourcePhaseExit(
 	return status.GetSuccessResourceCondition(), true, nil
 }
 
+// persistResource persists a resource and returns the resource condition for it.  Once the resource has been
+// persisted, the condition describes the readiness of the resource.  When requested with ResourceOptionWithWait,
+// the resource is only considered complete once it is ready, and a resource which has failed returns an error.
+func persistResource(
+	r workload.Reconciler,
+	req *workload.Request,
+	resource client.Object,
+	options ...ResourceOption,
+) (status.ChildResourceCondition, bool, error) {
+	condition, persisted, err := HandleResourcePhaseExit(
+		persistResourcePhase(r, req, resource, options...),
+	)
+	if !persisted || req.Plan != nil {
+		return condition, persisted, err
+	}
+
+	wait := hasResourceOption(ResourceOptionWithWait, options...)
+
+	readiness, err := resources.GetReadinessFromReconciler(r, req, resource, readyOptions(options...)...)
+	if err != nil {
+		if wait {
+			return status.GetFailResourceCondition(err), false, err
+		}
+
+		// the readiness is only informational when not waiting for the resource
+		r.GetLogger().Error(err, "unable to determine resource readiness", resources.MessageFor(resource)...)
+
+		return condition, true, nil
+	}
+
+	registerDiagnosis(r, req, resource, readiness)
+
+	if !wait {
+		return readiness.ToResourceCondition(), true, nil
+	}
+
+	return readiness.ToResourceCondition(), readiness.IsReady(), readiness.Err()
+}
+
 // persistResourcePhase executes persisting resources to the Kubernetes database.
 func persistResourcePhase(
 	r workload.Reconciler,
 	req *workload.Request,
 	resource client.Object,
-	wait bool,
+	options ...ResourceOption,
 ) (bool, error) {
+	// record the change without waiting when planning
+	if req.Plan != nil {
+		if err := CreateOrUpdate(r, req, resource, options...); err != nil {
+			return false, fmt.Errorf("unable to plan resource %s, %w", resource.GetName(), err)
+		}
+
+		return true, nil
+	}
+
 	ready, err := commonWait(r, req, resource)
 	if err != nil {
 		return false, err
@@ -111,23 +176,27 @@ func persistResourcePhase(
 	}
 
 	// persist the resource
-	if err := CreateOrUpdate(r, req, resource); err != nil {
+	if err := CreateOrUpdate(r, req, resource, options...); err != nil {
+		// the resource is not yet persisted while the previous resource is being deleted
+		if errors.Is(err, ErrResourceRecreating) {
+			r.GetLogger().Info("waiting for resource to be deleted before recreating", resources.MessageFor(resource)...)
+
+			return false, nil
+		}
+
 		if !IsOptimisticLockError(err) {
 			return false, fmt.Errorf("unable to create or update resource %s, %w", resource.GetName(), err)
 		}
 	}
 
-	// wait if requested
-	if wait {
-		return resources.IsReadyFromReconciler(r, req, resource)
-	}
-
 	return true, err
 }
 
 // CreateOrUpdate creates a resource if it does not already exist or updates a resource
-// if it does already exist.
-func CreateOrUpdate(r workload.Reconciler, req *workload.Request, resource client.Object) error {
+// if it does already exist.  When requested with ResourceOptionWithServerSideApply, the resource
+// is applied using server-side apply instead.  When the request is in plan mode, the change is
+// only recorded to the plan of the request.
+func CreateOrUpdate(r workload.Reconciler, req *workload.Request, resource client.Object, options ...ResourceOption) error {
 	// set ownership on the underlying resource being created or updated
 	if err := ctrl.SetControllerReference(req.Workload, resource, r.Scheme()); err != nil {
 		req.Log.Error(
@@ -139,23 +208,52 @@ func CreateOrUpdate(r workload.Reconciler, req *workload.Request, resource clien
 		return fmt.Errorf("unable to set owner reference on %s, %w", resource.GetName(), err)
 	}
 
+	// record the pod template of a job so that a changed template runs the job again
+	if err := resources.SetJobTemplateHash(resource); err != nil {
+		return fmt.Errorf("unable to set job template hash on %s, %w", resource.GetName(), err)
+	}
+
 	// get the resource from the cluster
 	clusterResource, err := resources.Get(r, req, resource)
 	if err != nil {
 		return fmt.Errorf("unable to retrieve resource %s, %w", resource.GetName(), err)
 	}
 
+	if req.Plan != nil {
+		return planResource(r, req, resource, clusterResource, options...)
+	}
+
+	// a resource which is being deleted, such as a resource which is being recreated, is created again
+	// once the deletion has completed
+	if clusterResource != nil && !clusterResource.GetDeletionTimestamp().IsZero() {
+		return recreate(r, req, resource, clusterResource, options...)
+	}
+
+	// the pod template of a job is immutable, so a job with a changed template is recreated so that it
+	// runs again with the new template
+	if clusterResource != nil && jobTemplateChanged(resource, clusterResource) {
+		return recreate(r, req, resource, clusterResource, options...)
+	}
+
 	// create the resource if we have a nil object, or update the resource if we have one
 	// that exists in the cluster already
-	if clusterResource == nil {
+	switch {
+	case hasResourceOption(ResourceOptionWithServerSideApply, options...):
+		err = apply(r, req, resource, clusterResource, hasResourceOption(ResourceOptionWithForceConflicts, options...))
+	case clusterResource == nil:
 		return create(r, req, resource)
+	default:
+		err = update(r, req, resource, clusterResource)
 	}
 
-	if err := update(r, req, resource, clusterResource); err != nil {
-		return fmt.Errorf("unable to update resource")
+	// recreate a resource when the update changes an immutable field and recreation is allowed
+	if err != nil && clusterResource != nil && shouldRecreate(resource, err, options...) {
+		r.GetLogger().Info("recreating resource with a changed immutable field", resources.MessageFor(resource)...)
+
+		return recreate(r, req, resource, clusterResource, options...)
 	}
 
-	return nil
+	return err
 }
 
 // create runs the logic to create a resource.
@@ -170,6 +268,34 @@ func create(r workload.Reconciler, req *workload.Request, resource client.Object
 	return reconcile.Watch(r, req, resource)
 }
 
+// apply runs the logic to apply a resource with server-side apply.  The server is responsible for
+// determining if a change is needed, so no equality check is performed prior to the request.  Resources
+// which are never updated, such as custom resource definitions, are only applied when they are created.
+func apply(r workload.Reconciler, req *workload.Request, desiredResource, currentResource client.Object, force bool) error {
+	if currentResource != nil && resources.SkipsUpdate(r, desiredResource) {
+		return nil
+	}
+
+	appliedResource, err := resources.Apply(r, req, desiredResource, force)
+	if err != nil {
+		return fmt.Errorf("unable to apply resource %s, %w", desiredResource.GetName(), err)
+	}
+
+	// add the created event and watch the newly created resource
+	if currentResource == nil {
+		status.Created.RegisterAction(r.GetEventRecorder(), desiredResource, req.Workload)
+
+		return reconcile.Watch(r, req, desiredResource)
+	}
+
+	// add the updated event only if the apply resulted in a change
+	if appliedResource.GetResourceVersion() != currentResource.GetResourceVersion() {
+		status.Updated.RegisterAction(r.GetEventRecorder(), desiredResource, req.Workload)
+	}
+
+	return nil
+}
+
 // update runs the logic to update a resource.
 func update(r workload.Reconciler, req *workload.Request, desiredResource, currentResource client.Object) error {
 	// return if the resource is already in a desired state (no update required)
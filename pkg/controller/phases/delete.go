@@ -5,11 +5,198 @@ package phases
 import (
 	"fmt"
 
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"sigs.k8s.io/controller-runtime/pkg/client"
 	"sigs.k8s.io/controller-runtime/pkg/controller/controllerutil"
 
 	"github.com/nukleros/operator-builder-tools/pkg/controller/workload"
+	"github.com/nukleros/operator-builder-tools/pkg/resources"
+	"github.com/nukleros/operator-builder-tools/pkg/status"
 )
 
+const (
+	// DeletePolicyAnnotation is the annotation which defines what happens to a child resource when
+	// its parent workload is deleted.
+	DeletePolicyAnnotation = "operator-builder.nukleros.io/delete-policy"
+
+	// DeletePolicyDelete deletes the child resource along with its dependents.  This is the default.
+	DeletePolicyDelete = "Delete"
+
+	// DeletePolicyOrphan deletes the child resource but orphans its dependents.
+	DeletePolicyOrphan = "Orphan"
+
+	// DeletePolicyRetain leaves the child resource in the cluster and removes the ownership of the
+	// parent workload so that it is not garbage collected.
+	DeletePolicyRetain = "Retain"
+)
+
+// DeleteResourcesPhase deletes the child resources of a workload in the reverse order in which they
+// are applied.  The order is taken from the same graph which is used to apply resources in parallel, so
+// that a resource is only deleted once every resource which depends on it, either by the order of its kind
+// or by its DependsOnAnnotation, has been fully removed from the cluster.  Resources which do not depend on
+// each other are deleted together and the phase requeues until all resources are gone.  Because resources
+// are deleted explicitly, cluster-scoped and cross-namespace resources which are not garbage collected are
+// also removed.  Only resources which are controlled by the workload are deleted, so that resources which
+// existed before the workload or which are shared with other owners are left in the cluster.
+func DeleteResourcesPhase(r workload.Reconciler, req *workload.Request, options ...ResourceOption) (bool, error) {
+	// get the resources in memory
+	desiredResources, err := workload.GetDesiredResources(r, req)
+	if err != nil {
+		return false, fmt.Errorf("unable to retrieve resources, %w", err)
+	}
+
+	graph, order := deletionOrder(r, desiredResources)
+
+	deleted := make([]bool, len(graph))
+
+	var waiting bool
+
+	for i := len(order) - 1; i >= 0; i-- {
+		node := order[i]
+
+		// wait for the dependents of a resource to be removed before deleting it
+		if !dependentsDeleted(graph[node], deleted) {
+			continue
+		}
+
+		deleted[node], err = deleteResource(r, req, graph[node].resource)
+		if err != nil {
+			return false, err
+		}
+
+		if !deleted[node] {
+			r.GetLogger().Info("waiting for resource to be deleted", resources.MessageFor(graph[node].resource)...)
+
+			waiting = true
+		}
+	}
+
+	return !waiting, nil
+}
+
+// deletionOrder returns the apply graph of the desired resources along with the order in which they are
+// applied.  An invalid DependsOnAnnotation would otherwise prevent the workload from ever being deleted, so
+// invalid references are logged and ignored, and the order of kinds alone is used when the references
+// form a cycle.
+func deletionOrder(r workload.Reconciler, desiredResources []client.Object) ([]*applyNode, []int) {
+	graph := newTierGraph(desiredResources)
+
+	if err := addExplicitDependencies(graph); err != nil {
+		r.GetLogger().Error(err, "ignoring invalid dependencies for deletion")
+	}
+
+	order, err := sortApplyGraph(graph)
+	if err == nil {
+		return graph, order
+	}
+
+	r.GetLogger().Error(err, "ignoring explicit dependencies for deletion")
+
+	// the order of kinds never contains a cycle
+	graph = newTierGraph(desiredResources)
+	order, _ = sortApplyGraph(graph)
+
+	return graph, order
+}
+
+// dependentsDeleted determines if all of the dependents of a node have been removed from the cluster.
+func dependentsDeleted(node *applyNode, deleted []bool) bool {
+	for _, dependent := range node.dependents {
+		if !deleted[dependent] {
+			return false
+		}
+	}
+
+	return true
+}
+
+// deleteResource requests the deletion of a single resource and returns whether the resource
+// is gone from the cluster.
+func deleteResource(r workload.Reconciler, req *workload.Request, resource client.Object) (bool, error) {
+	clusterResource, err := resources.Get(r, req, resource)
+	if err != nil {
+		return false, fmt.Errorf("unable to retrieve resource %s for deletion, %w", resource.GetName(), err)
+	}
+
+	if clusterResource == nil {
+		return true, nil
+	}
+
+	deletePolicy := resource.GetAnnotations()[DeletePolicyAnnotation]
+
+	// do not delete a resource which has been adopted by a different controller, and retain a resource
+	// without a controller as it existed before the workload or is shared with other owners
+	if owner := metav1.GetControllerOf(clusterResource); owner == nil {
+		r.GetLogger().V(2).Info("retaining resource which is not controlled by the workload", resources.MessageFor(resource)...)
+
+		deletePolicy = DeletePolicyRetain
+	} else if owner.UID != req.Workload.GetUID() {
+		r.GetLogger().V(2).Info("skipping deletion of resource controlled by another owner", resources.MessageFor(resource)...)
+
+		return true, nil
+	}
+
+	var propagationPolicy metav1.DeletionPropagation
+
+	switch deletePolicy {
+	case DeletePolicyRetain:
+		if err := releaseResource(r, req, clusterResource); err != nil {
+			return false, fmt.Errorf("unable to retain resource %s, %w", resource.GetName(), err)
+		}
+
+		return true, nil
+	case DeletePolicyOrphan:
+		propagationPolicy = metav1.DeletePropagationOrphan
+	default:
+		propagationPolicy = metav1.DeletePropagationForeground
+	}
+
+	// only request the deletion once and wait for the resource to be removed
+	if clusterResource.GetDeletionTimestamp().IsZero() {
+		if err := resources.Delete(r, req, clusterResource, client.PropagationPolicy(propagationPolicy)); err != nil {
+			return false, fmt.Errorf("unable to delete resource %s, %w", resource.GetName(), err)
+		}
+
+		// add the deleted event
+		status.Deleted.RegisterAction(r.GetEventRecorder(), clusterResource, req.Workload)
+	}
+
+	return false, nil
+}
+
+// releaseResource removes the owner references of the workload from a resource so that the
+// resource is not garbage collected once the workload is deleted.
+func releaseResource(r workload.Reconciler, req *workload.Request, clusterResource client.Object) error {
+	current, ok := clusterResource.(*unstructured.Unstructured)
+	if !ok {
+		return fmt.Errorf("unexpected type %T for resource %s", clusterResource, clusterResource.GetName())
+	}
+
+	released := current.DeepCopy()
+	ownerReferences := []metav1.OwnerReference{}
+
+	for _, ownerReference := range current.GetOwnerReferences() {
+		if ownerReference.UID != req.Workload.GetUID() {
+			ownerReferences = append(ownerReferences, ownerReference)
+		}
+	}
+
+	if len(ownerReferences) == len(current.GetOwnerReferences()) {
+		return nil
+	}
+
+	released.SetOwnerReferences(ownerReferences)
+
+	r.GetLogger().Info("retaining resource", resources.MessageFor(released)...)
+
+	if err := r.Patch(req.Context, released, client.MergeFrom(current)); err != nil {
+		return fmt.Errorf("unable to remove owner reference; %w", err)
+	}
+
+	return nil
+}
+
 // DeletionCompletePhase executes the completion of a reconciliation loop for a delete request.
 func DeletionCompletePhase(r workload.Reconciler, req *workload.Request, options ...ResourceOption) (bool, error) {
 	req.Log.Info("successfully deleted")
@@ -0,0 +1,362 @@
+/*
+	SPDX-License-Identifier: MIT
+*/
+
+package phases
+
+import (
+	"context"
+	"reflect"
+	"testing"
+
+	"github.com/go-logr/logr"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	"k8s.io/apimachinery/pkg/types"
+	"k8s.io/client-go/kubernetes/scheme"
+	"k8s.io/client-go/tools/events"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/client/fake"
+	"sigs.k8s.io/controller-runtime/pkg/client/interceptor"
+	"sigs.k8s.io/controller-runtime/pkg/controller"
+	"sigs.k8s.io/controller-runtime/pkg/manager"
+
+	"github.com/nukleros/operator-builder-tools/pkg/controller/workload"
+	"github.com/nukleros/operator-builder-tools/pkg/status"
+)
+
+const testWorkloadUID = types.UID("workload-uid")
+
+// testWorkload is a workload which stores its child resource conditions in memory.
+type testWorkload struct {
+	*unstructured.Unstructured
+
+	conditions   []*status.ChildResource
+	dependencies []workload.Workload
+	collection   *workload.CollectionReference
+}
+
+func newTestWorkload(conditions ...*status.ChildResource) *testWorkload {
+	object := &unstructured.Unstructured{}
+	object.SetGroupVersionKind(schema.GroupVersionKind{Group: "apps.example.com", Version: "v1", Kind: "App"})
+	object.SetName("app")
+	object.SetNamespace("default")
+	object.SetUID(testWorkloadUID)
+
+	return &testWorkload{Unstructured: object, conditions: conditions}
+}
+
+func (w *testWorkload) GetWorkloadGVK() schema.GroupVersionKind             { return w.GroupVersionKind() }
+func (w *testWorkload) GetDependencies() []workload.Workload                { return w.dependencies }
+func (w *testWorkload) GetDependencyStatus() bool                           { return len(w.dependencies) == 0 }
+func (w *testWorkload) GetReadyStatus() bool                                { return true }
+func (w *testWorkload) GetPhaseConditions() []*status.PhaseCondition        { return nil }
+func (w *testWorkload) GetChildResourceConditions() []*status.ChildResource { return w.conditions }
+func (w *testWorkload) SetReadyStatus(bool)                                 {}
+func (w *testWorkload) SetDependencyStatus(bool)                            {}
+func (w *testWorkload) SetPhaseCondition(*status.PhaseCondition)            {}
+
+func (w *testWorkload) GetCollectionReference() *workload.CollectionReference {
+	return w.collection
+}
+
+func (w *testWorkload) SetChildResourceCondition(condition *status.ChildResource) {
+	w.conditions = append(w.conditions, condition)
+}
+
+func (w *testWorkload) RemoveChildResourceCondition(condition *status.ChildResource) {
+	conditions := []*status.ChildResource{}
+
+	for _, existing := range w.conditions {
+		if existing != condition {
+			conditions = append(conditions, existing)
+		}
+	}
+
+	w.conditions = conditions
+}
+
+// testReconciler is a reconciler backed by a fake client which records the deletions which are
+// requested and ignores updates to the status of the workload.
+type testReconciler struct {
+	client.Client
+
+	resources []client.Object
+	recorder  *events.FakeRecorder
+	deleted   []string
+	policies  map[string]metav1.DeletionPropagation
+}
+
+func newTestReconciler(desired []client.Object, existing ...client.Object) *testReconciler {
+	r := &testReconciler{
+		resources: desired,
+		recorder:  events.NewFakeRecorder(len(existing)),
+		policies:  map[string]metav1.DeletionPropagation{},
+	}
+
+	r.Client = fake.NewClientBuilder().
+		WithScheme(scheme.Scheme).
+		WithObjects(existing...).
+		WithInterceptorFuncs(interceptor.Funcs{
+			Delete: func(ctx context.Context, c client.WithWatch, obj client.Object, opts ...client.DeleteOption) error {
+				deleteOptions := &client.DeleteOptions{}
+				deleteOptions.ApplyOptions(opts)
+
+				r.deleted = append(r.deleted, obj.GetName())
+				r.policies[obj.GetName()] = *deleteOptions.PropagationPolicy
+
+				return c.Delete(ctx, obj, opts...)
+			},
+			SubResourceUpdate: func(context.Context, client.Client, string, client.Object, ...client.SubResourceUpdateOption) error {
+				return nil
+			},
+		}).
+		Build()
+
+	return r
+}
+
+func (r *testReconciler) GetLogger() logr.Logger                 { return logr.Discard() }
+func (r *testReconciler) GetEventRecorder() events.EventRecorder { return r.recorder }
+func (r *testReconciler) GetFieldManager() string                { return "test" }
+
+func (r *testReconciler) GetResources(*workload.Request) ([]client.Object, error) {
+	return r.resources, nil
+}
+
+func (r *testReconciler) Mutate(_ *workload.Request, object client.Object) ([]client.Object, bool, error) {
+	return []client.Object{object}, false, nil
+}
+
+// the remaining methods of the reconciler are not used by the phases under test
+func (r *testReconciler) GetController() controller.Controller       { return nil }
+func (r *testReconciler) GetManager() manager.Manager                { return nil }
+func (r *testReconciler) GetWatches() []client.Object                { return nil }
+func (r *testReconciler) SetWatch(client.Object)                     {}
+func (r *testReconciler) CheckReady(*workload.Request) (bool, error) { return true, nil }
+
+// exists determines if a child resource exists in the fake cluster.
+func (r *testReconciler) exists(t *testing.T, child client.Object) bool {
+	t.Helper()
+
+	object := &unstructured.Unstructured{}
+	object.SetGroupVersionKind(child.GetObjectKind().GroupVersionKind())
+
+	err := r.Get(context.Background(), client.ObjectKeyFromObject(child), object)
+	if err != nil {
+		if client.IgnoreNotFound(err) != nil {
+			t.Fatalf("unable to get resource %s, %v", child.GetName(), err)
+		}
+
+		return false
+	}
+
+	return true
+}
+
+// newChildResource returns a child resource of a kind.  When an owner is given, the owner is set as the
+// controller of the resource.
+func newChildResource(apiVersion, kind, name string, owner types.UID, annotations map[string]string) *unstructured.Unstructured {
+	resource := &unstructured.Unstructured{}
+	resource.SetAPIVersion(apiVersion)
+	resource.SetKind(kind)
+	resource.SetName(name)
+	resource.SetNamespace("default")
+	resource.SetAnnotations(annotations)
+
+	if owner != "" {
+		controller := true
+
+		resource.SetOwnerReferences([]metav1.OwnerReference{{
+			APIVersion: "apps.example.com/v1",
+			Kind:       "App",
+			Name:       string(owner),
+			UID:        owner,
+			Controller: &controller,
+		}})
+	}
+
+	return resource
+}
+
+func TestDeleteResourcesPhase(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name          string
+		desired       []client.Object
+		existing      []client.Object
+		wantDeleted   []string
+		wantPolicies  map[string]metav1.DeletionPropagation
+		wantRemaining []string
+	}{
+		{
+			name: "resources are deleted in reverse order",
+			desired: []client.Object{
+				newChildResource("v1", "ConfigMap", "config", "", nil),
+				newChildResource("apps/v1", "Deployment", "app", "", nil),
+				newChildResource("v1", "Service", "web", "", nil),
+			},
+			existing: []client.Object{
+				newChildResource("v1", "ConfigMap", "config", testWorkloadUID, nil),
+				newChildResource("apps/v1", "Deployment", "app", testWorkloadUID, nil),
+				newChildResource("v1", "Service", "web", testWorkloadUID, nil),
+			},
+			wantDeleted: []string{"web", "app", "config"},
+			wantPolicies: map[string]metav1.DeletionPropagation{
+				"web":    metav1.DeletePropagationForeground,
+				"app":    metav1.DeletePropagationForeground,
+				"config": metav1.DeletePropagationForeground,
+			},
+		},
+		{
+			name: "explicit dependencies are deleted after their dependents",
+			desired: []client.Object{
+				newChildResource("v1", "Service", "database", "", nil),
+				newChildResource("apps/v1", "Deployment", "app", "", map[string]string{DependsOnAnnotation: "Service/database"}),
+			},
+			existing: []client.Object{
+				newChildResource("v1", "Service", "database", testWorkloadUID, nil),
+				newChildResource("apps/v1", "Deployment", "app", testWorkloadUID, nil),
+			},
+			wantDeleted: []string{"app", "database"},
+			wantPolicies: map[string]metav1.DeletionPropagation{
+				"app":      metav1.DeletePropagationForeground,
+				"database": metav1.DeletePropagationForeground,
+			},
+		},
+		{
+			name: "invalid dependencies are ignored",
+			desired: []client.Object{
+				newChildResource("apps/v1", "Deployment", "app", "", map[string]string{DependsOnAnnotation: "Service/missing"}),
+			},
+			existing: []client.Object{
+				newChildResource("apps/v1", "Deployment", "app", testWorkloadUID, nil),
+			},
+			wantDeleted:  []string{"app"},
+			wantPolicies: map[string]metav1.DeletionPropagation{"app": metav1.DeletePropagationForeground},
+		},
+		{
+			name: "resources with the orphan policy orphan their dependents",
+			desired: []client.Object{
+				newChildResource("apps/v1", "Deployment", "app", "", map[string]string{DeletePolicyAnnotation: DeletePolicyOrphan}),
+			},
+			existing: []client.Object{
+				newChildResource("apps/v1", "Deployment", "app", testWorkloadUID, nil),
+			},
+			wantDeleted:  []string{"app"},
+			wantPolicies: map[string]metav1.DeletionPropagation{"app": metav1.DeletePropagationOrphan},
+		},
+		{
+			name: "resources with the retain policy are not deleted",
+			desired: []client.Object{
+				newChildResource("v1", "ConfigMap", "config", "", map[string]string{DeletePolicyAnnotation: DeletePolicyRetain}),
+			},
+			existing: []client.Object{
+				newChildResource("v1", "ConfigMap", "config", testWorkloadUID, nil),
+			},
+			wantRemaining: []string{"config"},
+		},
+		{
+			name: "resources controlled by another owner are not deleted",
+			desired: []client.Object{
+				newChildResource("v1", "ConfigMap", "config", "", nil),
+			},
+			existing: []client.Object{
+				newChildResource("v1", "ConfigMap", "config", "other-uid", nil),
+			},
+			wantRemaining: []string{"config"},
+		},
+		{
+			name: "resources without a controller are not deleted",
+			desired: []client.Object{
+				newChildResource("v1", "ConfigMap", "config", "", nil),
+			},
+			existing: []client.Object{
+				newChildResource("v1", "ConfigMap", "config", "", nil),
+			},
+			wantRemaining: []string{"config"},
+		},
+	}
+
+	for _, tt := range tests {
+		tt := tt
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			r := newTestReconciler(tt.desired, tt.existing...)
+			req := &workload.Request{Context: context.Background(), Workload: newTestWorkload()}
+
+			// the phase requeues until all resources are gone
+			var done bool
+
+			for pass := 0; pass < 5 && !done; pass++ {
+				var err error
+
+				done, err = DeleteResourcesPhase(r, req)
+				if err != nil {
+					t.Fatalf("DeleteResourcesPhase() error = %v", err)
+				}
+			}
+
+			if !done {
+				t.Fatalf("DeleteResourcesPhase() did not complete")
+			}
+
+			if tt.wantDeleted == nil {
+				tt.wantDeleted = []string{}
+			}
+
+			if r.deleted == nil {
+				r.deleted = []string{}
+			}
+
+			if !reflect.DeepEqual(r.deleted, tt.wantDeleted) {
+				t.Errorf("DeleteResourcesPhase() deleted = %v, want %v", r.deleted, tt.wantDeleted)
+			}
+
+			if tt.wantPolicies == nil {
+				tt.wantPolicies = map[string]metav1.DeletionPropagation{}
+			}
+
+			if !reflect.DeepEqual(r.policies, tt.wantPolicies) {
+				t.Errorf("DeleteResourcesPhase() policies = %v, want %v", r.policies, tt.wantPolicies)
+			}
+
+			for _, existing := range tt.existing {
+				remaining := r.exists(t, existing)
+				wantRemaining := containsString(tt.wantRemaining, existing.GetName())
+
+				if remaining != wantRemaining {
+					t.Errorf("DeleteResourcesPhase() resource %s remaining = %v, want %v", existing.GetName(), remaining, wantRemaining)
+				}
+			}
+		})
+	}
+}
+
+func TestDeleteResourcesPhase_Retain(t *testing.T) {
+	t.Parallel()
+
+	desired := newChildResource("v1", "ConfigMap", "config", "", map[string]string{DeletePolicyAnnotation: DeletePolicyRetain})
+	existing := newChildResource("v1", "ConfigMap", "config", testWorkloadUID, nil)
+
+	r := newTestReconciler([]client.Object{desired}, existing)
+	req := &workload.Request{Context: context.Background(), Workload: newTestWorkload()}
+
+	if _, err := DeleteResourcesPhase(r, req); err != nil {
+		t.Fatalf("DeleteResourcesPhase() error = %v", err)
+	}
+
+	retained := &unstructured.Unstructured{}
+	retained.SetGroupVersionKind(existing.GroupVersionKind())
+
+	if err := r.Get(context.Background(), client.ObjectKeyFromObject(existing), retained); err != nil {
+		t.Fatalf("unable to get retained resource, %v", err)
+	}
+
+	if owners := retained.GetOwnerReferences(); len(owners) != 0 {
+		t.Errorf("DeleteResourcesPhase() retained resource owners = %v, want none", owners)
+	}
+}
@@ -3,65 +3,148 @@
 package phases
 
 import (
+	"errors"
 	"fmt"
+	"strings"
 
 	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
 	"sigs.k8s.io/controller-runtime/pkg/client"
 
+	"github.com/nukleros/operator-builder-tools/pkg/controller/reconcile"
 	"github.com/nukleros/operator-builder-tools/pkg/controller/workload"
 )
 
-// DependencyPhase executes a dependency check prior to attempting to create resources.
+// ErrUnmetDependencies is returned when the dependencies of a workload are not yet satisfied.
+var ErrUnmetDependencies = errors.New("dependencies are not satisfied")
+
+// DependencyPhase executes a dependency check prior to attempting to create resources.  The phase is
+// left pending with the unmet dependencies in its condition message until all dependencies are satisfied.
+// The dependencies are watched so that the workload is requeued when the status of a dependency changes,
+// except when planning, as planning must not register watches on the controller.
 func DependencyPhase(r workload.Reconciler, req *workload.Request, options ...ResourceOption) (bool, error) {
 	if !req.Workload.GetDependencyStatus() {
-		satisfied, err := dependenciesSatisfied(r, req)
+		// watch the dependencies so that this workload is requeued as soon as they change
+		if req.Plan == nil {
+			if err := reconcile.WatchDependencies(r, req); err != nil {
+				return false, fmt.Errorf("unable to watch dependencies, %w", err)
+			}
+		}
+
+		unmet, err := unmetDependencies(r, req)
 		if err != nil {
 			return false, fmt.Errorf("unable to list dependencies, %w", err)
 		}
 
-		return satisfied, nil
+		if len(unmet) > 0 {
+			return false, fmt.Errorf("%w; unmet dependencies [%s]", ErrUnmetDependencies, strings.Join(unmet, ", "))
+		}
+
+		req.Workload.SetDependencyStatus(true)
 	}
 
 	return true, nil
 }
 
-// dependenciesSatisfied will return whether or not all dependencies are satisfied for a component.
-func dependenciesSatisfied(r workload.Reconciler, req *workload.Request) (bool, error) {
-	for _, dep := range req.Workload.GetDependencies() {
-		satisfied, err := dependencySatisfied(r, req, dep)
-		if err != nil || !satisfied {
-			return false, err
+// unmetDependencies will return a description of each dependency which is not satisfied for a component.
+func unmetDependencies(r workload.Reconciler, req *workload.Request) ([]string, error) {
+	unmet := []string{}
+
+	for _, dependency := range workload.GetDependencyReferences(req.Workload) {
+		reason, err := dependencySatisfied(r, req, dependency)
+		if err != nil {
+			return nil, err
+		}
+
+		if reason != "" {
+			unmet = append(unmet, fmt.Sprintf("%s (%s)", dependency, reason))
 		}
 	}
 
-	return true, nil
+	return unmet, nil
 }
 
-// dependencySatisfied will return whether or not an individual dependency is satisfied.
-func dependencySatisfied(r workload.Reconciler, req *workload.Request, dependency workload.Workload) (bool, error) {
+// dependencySatisfied will return whether or not an individual dependency is satisfied.  It returns the
+// reason that the dependency is not satisfied, or an empty string if it is satisfied.
+func dependencySatisfied(r workload.Reconciler, req *workload.Request, dependency *workload.DependencyReference) (string, error) {
 	// get the dependencies by kind that already exist in cluster
 	dependencyList := &unstructured.UnstructuredList{}
 
-	dependencyList.SetGroupVersionKind(dependency.GetWorkloadGVK())
+	dependencyList.SetGroupVersionKind(dependency.GroupVersionKind)
 
-	if err := r.List(req.Context, dependencyList, &client.ListOptions{}); err != nil {
-		return false, fmt.Errorf("unable to list dependencies, %w", err)
+	if err := r.List(req.Context, dependencyList, client.InNamespace(dependency.Namespace)); err != nil {
+		return "", fmt.Errorf("unable to list dependencies, %w", err)
 	}
 
-	// expect only one item returned, otherwise dependencies are considered unsatisfied
-	if len(dependencyList.Items) != 1 {
-		return false, nil
+	var matched, ready int
+
+	for i := range dependencyList.Items {
+		matches, err := dependency.Matches(&dependencyList.Items[i])
+		if err != nil {
+			return "", err
+		}
+
+		if !matches {
+			continue
+		}
+
+		matched++
+
+		isReady, err := dependencyIsReady(&dependencyList.Items[i], dependency.ConditionType)
+		if err != nil {
+			return "", err
+		}
+
+		if isReady {
+			ready++
+		}
+	}
+
+	switch {
+	case matched == 0:
+		return "not found", nil
+	case dependency.RequiresOne() && matched > 1:
+		return fmt.Sprintf("%d found, expected exactly one", matched), nil
+	case dependency.RequiresAll() && ready < matched:
+		return fmt.Sprintf("%d/%d ready", ready, matched), nil
+	case ready == 0:
+		return "none ready", nil
+	}
+
+	return "", nil
+}
+
+// dependencyIsReady determines if a dependency workload is ready.  When a condition type is provided, the
+// condition of that type must be True, otherwise the status.created field must be true.
+func dependencyIsReady(dependency *unstructured.Unstructured, conditionType string) (bool, error) {
+	if conditionType == "" {
+		// get the status.created field on the object and return the status and any errors found
+		created, found, err := unstructured.NestedBool(dependency.Object, "status", "created")
+		if err != nil {
+			return false, fmt.Errorf("unable to retrieve status.created field, %w", err)
+		}
+
+		return found && created, nil
 	}
 
-	// get the status.created field on the object and return the status and any errors found
-	status, found, err := unstructured.NestedBool(dependencyList.Items[0].Object, "status", "created")
+	conditions, found, err := unstructured.NestedSlice(dependency.Object, "status", "conditions")
 	if err != nil {
-		return false, fmt.Errorf("unable to retrieve status.created field, %w", err)
+		return false, fmt.Errorf("unable to retrieve status.conditions field, %w", err)
 	}
 
 	if !found {
 		return false, nil
 	}
 
-	return status, nil
+	for _, condition := range conditions {
+		fields, ok := condition.(map[string]any)
+		if !ok {
+			continue
+		}
+
+		if fields["type"] == conditionType {
+			return fields["status"] == "True", nil
+		}
+	}
+
+	return false, nil
 }
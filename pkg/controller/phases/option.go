@@ -2,7 +2,11 @@
 
 package phases
 
-import ctrl "sigs.k8s.io/controller-runtime"
+import (
+	ctrl "sigs.k8s.io/controller-runtime"
+
+	"github.com/nukleros/operator-builder-tools/pkg/resources"
+)
 
 // PhaseOption is a function pattern to allow customization of a phase upon registration.
 type PhaseOption func(*Phase)
@@ -12,6 +16,36 @@ type ResourceOption int
 
 const (
 	ResourceOptionWithWait = iota
+
+	// ResourceOptionWithServerSideApply persists resources using server-side apply with the
+	// field manager of the reconciler rather than calculating differences and patching.
+	ResourceOptionWithServerSideApply
+
+	// ResourceOptionWithForceConflicts takes ownership of fields which are owned by other field
+	// managers.  It only has an effect when used with ResourceOptionWithServerSideApply.
+	ResourceOptionWithForceConflicts
+
+	// ResourceOptionWithParallelApply applies independent resources concurrently.  Resources are
+	// ordered by kind and by the DependsOnAnnotation of each resource.
+	ResourceOptionWithParallelApply
+
+	// ResourceOptionWithGenericReadiness determines the readiness of unknown resources without ready
+	// annotations from their status.observedGeneration and status.conditions fields.
+	ResourceOptionWithGenericReadiness
+
+	// ResourceOptionWithPodDiagnostics lists the pods of a Deployment, StatefulSet, DaemonSet or Job which
+	// is not ready and includes a diagnosis of the problems with its pods in its resource condition.  A
+	// warning event is registered against the parent workload when the diagnosis changes.  Listing pods
+	// requires the following RBAC marker on the controller:
+	//
+	//	+kubebuilder:rbac:groups=core,resources=pods,verbs=get;list;watch
+	ResourceOptionWithPodDiagnostics
+
+	// ResourceOptionWithRecreate recreates child resources when an update is rejected because it changes
+	// an immutable field, such as the selector of a Deployment.  Recreating a resource deletes it, which
+	// removes its pods or, for a Service, its cluster IP.  Individual resources may opt in or out with
+	// the RecreatePolicyAnnotation instead.
+	ResourceOptionWithRecreate
 )
 
 // WithCustomRequeueResult allows you to define a custom result for a phase when it is requeued,
@@ -22,6 +56,15 @@ func WithCustomRequeueResult(requeueResult ctrl.Result) PhaseOption {
 	}
 }
 
+// WithPlanning marks a phase as safe to execute in plan mode.  The built-in phases support planning by
+// default.  Other phases are skipped in plan mode unless they are registered with this option, in which
+// case they must record their changes to the plan of the request rather than persisting them.
+func WithPlanning() PhaseOption {
+	return func(p *Phase) {
+		p.planned = true
+	}
+}
+
 // WithResourceOptions adds the requested resource options to the phase.
 func WithResourceOptions(options ...ResourceOption) PhaseOption {
 	return func(p *Phase) {
@@ -39,6 +82,22 @@ func WithResourceOptions(options ...ResourceOption) PhaseOption {
 	}
 }
 
+// readyOptions returns the options used to determine the readiness of resources from a set of
+// resource options.
+func readyOptions(options ...ResourceOption) []resources.ReadyOption {
+	var readyOptions []resources.ReadyOption
+
+	if hasResourceOption(ResourceOptionWithGenericReadiness, options...) {
+		readyOptions = append(readyOptions, resources.ReadyOptionWithGenericReadiness)
+	}
+
+	if hasResourceOption(ResourceOptionWithPodDiagnostics, options...) {
+		readyOptions = append(readyOptions, resources.ReadyOptionWithPodDiagnostics)
+	}
+
+	return readyOptions
+}
+
 // hasResourceOption returns true if a set of resource options has a given
 // resource option.
 func hasResourceOption(option ResourceOption, options ...ResourceOption) bool {
@@ -21,6 +21,7 @@ type Phase struct {
 	definition      HandlerFunc
 	requeueResult   *ctrl.Result
 	resourceOptions []ResourceOption
+	planned         bool
 }
 
 // Requeue will return the phase's reconcile result when requeueing is needed.
@@ -51,6 +52,15 @@ func (p *Phase) handlePhaseExit(
 	var result ctrl.Result
 
 	switch {
+	case phaseError != nil && IsPendingError(phaseError):
+		condition = status.GetPendingConditionWithError(p.Name, phaseError)
+		phaseError = nil
+		result = p.Requeue()
+	case phaseError != nil && IsTerminalError(phaseError):
+		// the child resource is watched, so the phase runs again once the child resource changes
+		condition = status.GetFailCondition(p.Name, phaseError)
+		phaseError = nil
+		result = ctrl.Result{}
 	case phaseError != nil:
 		if IsOptimisticLockError(phaseError) {
 			phaseError = nil
@@ -85,6 +95,11 @@ func (p *Phase) handlePhaseExit(
 
 // updatePhaseConditions updates the status.conditions field of the parent custom resource.
 func updatePhaseConditions(r workload.Reconciler, req *workload.Request, condition *status.PhaseCondition) error {
+	// the status is not persisted when planning
+	if req.Plan != nil {
+		return nil
+	}
+
 	req.Workload.SetPhaseCondition(condition)
 
 	if err := r.Status().Update(req.Context, req.Workload); err != nil {
@@ -0,0 +1,152 @@
+// SPDX-License-Identifier: MIT
+
+package phases
+
+import (
+	"fmt"
+	"reflect"
+
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	"github.com/nukleros/operator-builder-tools/pkg/controller/plan"
+	"github.com/nukleros/operator-builder-tools/pkg/controller/workload"
+	"github.com/nukleros/operator-builder-tools/pkg/resources"
+)
+
+// Plan executes the phases for the current lifecycle event of a workload in plan mode and returns the
+// changes which would be made to its child resources.  No changes are persisted to the cluster, including
+// the status of the workload, and no watches are registered on the controller.  Phases for the delete
+// lifecycle event are never planned.
+//
+// Only the phases which support planning are executed; other phases are recorded as skipped on the plan.
+// When a phase is not able to proceed, such as a phase which is waiting on dependencies, the phases after
+// it are not planned and the phase is recorded on the plan.  Use Plan.IsComplete to determine if all
+// phases were planned.
+func (registry *Registry) Plan(r workload.Reconciler, req *workload.Request) (*plan.Plan, error) {
+	req.Plan = plan.New()
+
+	defer func() {
+		req.Plan = nil
+	}()
+
+	event := UpdateEvent
+	if !req.Workload.GetReadyStatus() {
+		event = CreateEvent
+	}
+
+	result := req.Plan
+
+	if _, err := registry.Execute(r, req, event); err != nil {
+		return result, fmt.Errorf("unable to plan changes for %s, %w", req.Workload.GetWorkloadGVK().Kind, err)
+	}
+
+	return result, nil
+}
+
+// supportsPlanning determines if a phase handler is one of the built-in phases which record their changes
+// to the plan of a request rather than persisting them.
+func supportsPlanning(definition HandlerFunc) bool {
+	for _, planned := range []HandlerFunc{
+		DependencyPhase,
+		CollectionPhase,
+		CreateResourcesPhase,
+		CheckReadyPhase,
+		PruneResourcesPhase,
+		CompletePhase,
+	} {
+		if reflect.ValueOf(planned).Pointer() == reflect.ValueOf(definition).Pointer() {
+			return true
+		}
+	}
+
+	return false
+}
+
+// planResource records the change which would be made to a resource rather than persisting it.  When
+// server-side apply is requested, the change is calculated using a server dry run, otherwise the change
+// is calculated from the difference between the current resource and the desired resource.
+func planResource(
+	r workload.Reconciler,
+	req *workload.Request,
+	desiredResource, currentResource client.Object,
+	options ...ResourceOption,
+) error {
+	desired, err := resources.ToUnstructured(desiredResource)
+	if err != nil {
+		return fmt.Errorf("unable to convert resource %s for planning, %w", desiredResource.GetName(), err)
+	}
+
+	if currentResource == nil {
+		req.Plan.Record(plan.ActionCreate, desiredResource, plan.Diff(nil, desired.Object))
+
+		return nil
+	}
+
+	current, err := resources.ToUnstructured(currentResource)
+	if err != nil {
+		return fmt.Errorf("unable to convert resource %s for planning, %w", desiredResource.GetName(), err)
+	}
+
+	if jobTemplateChanged(desiredResource, currentResource) {
+		req.Plan.Record(plan.ActionRecreate, desiredResource, plan.Diff(current.Object, desired.Object))
+
+		return nil
+	}
+
+	var planned *unstructured.Unstructured
+
+	if hasResourceOption(ResourceOptionWithServerSideApply, options...) {
+		if resources.SkipsUpdate(r, desiredResource) {
+			req.Plan.Record(plan.ActionNoop, desiredResource, nil)
+
+			return nil
+		}
+
+		planned, err = resources.Apply(
+			r,
+			req,
+			desiredResource,
+			hasResourceOption(ResourceOptionWithForceConflicts, options...),
+			client.DryRunAll,
+		)
+		if err != nil {
+			if shouldRecreate(desiredResource, err, options...) {
+				req.Plan.Record(plan.ActionRecreate, desiredResource, plan.Diff(current.Object, desired.Object))
+
+				return nil
+			}
+
+			return fmt.Errorf("unable to plan resource %s, %w", desiredResource.GetName(), err)
+		}
+	} else {
+		var needsUpdate bool
+
+		needsUpdate, err = resources.NeedsUpdate(r, desiredResource, currentResource)
+		if err != nil {
+			return fmt.Errorf("unable to plan resource %s, %w", desiredResource.GetName(), err)
+		}
+
+		if !needsUpdate {
+			req.Plan.Record(plan.ActionNoop, desiredResource, nil)
+
+			return nil
+		}
+
+		planned, err = resources.Merge(desiredResource, currentResource)
+		if err != nil {
+			return fmt.Errorf("unable to plan resource %s, %w", desiredResource.GetName(), err)
+		}
+	}
+
+	diff := plan.Diff(current.Object, planned.Object)
+	if len(diff) == 0 {
+		req.Plan.Record(plan.ActionNoop, desiredResource, nil)
+
+		return nil
+	}
+
+	req.Plan.Record(plan.ActionUpdate, desiredResource, diff)
+
+	return nil
+}
@@ -0,0 +1,82 @@
+/*
+	SPDX-License-Identifier: MIT
+*/
+
+package phases
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	"github.com/nukleros/operator-builder-tools/pkg/controller/plan"
+	"github.com/nukleros/operator-builder-tools/pkg/controller/workload"
+)
+
+// planReconciler is a reconciler which fails the test when a watch is registered.
+type planReconciler struct {
+	*testReconciler
+
+	t *testing.T
+}
+
+func (r *planReconciler) GetWatches() []client.Object {
+	r.t.Fatalf("watch registered while planning")
+
+	return nil
+}
+
+func TestPlan_Watches(t *testing.T) {
+	t.Parallel()
+
+	configMapGVK := schema.GroupVersionKind{Version: "v1", Kind: "ConfigMap"}
+
+	dependency := newTestWorkload()
+	dependency.SetGroupVersionKind(configMapGVK)
+	dependency.SetName("settings")
+
+	tests := []struct {
+		name     string
+		phase    HandlerFunc
+		workload *testWorkload
+		wantErr  error
+	}{
+		{
+			name:     "dependencies are not watched while planning",
+			phase:    DependencyPhase,
+			workload: &testWorkload{Unstructured: newTestWorkload().Unstructured, dependencies: []workload.Workload{dependency}},
+			wantErr:  ErrUnmetDependencies,
+		},
+		{
+			name:  "collections are not watched while planning",
+			phase: CollectionPhase,
+			workload: &testWorkload{
+				Unstructured: newTestWorkload().Unstructured,
+				collection:   &workload.CollectionReference{GroupVersionKind: configMapGVK, Name: "platform", Namespace: "default"},
+			},
+			wantErr: workload.ErrInvalidWorkload,
+		},
+	}
+
+	for _, tt := range tests {
+		tt := tt
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			r := &planReconciler{testReconciler: newTestReconciler(nil), t: t}
+			req := &workload.Request{Context: context.Background(), Workload: tt.workload, Plan: plan.New()}
+
+			proceed, err := tt.phase(r, req)
+			if !errors.Is(err, tt.wantErr) {
+				t.Errorf("phase error = %v, wantErr %v", err, tt.wantErr)
+			}
+
+			if proceed {
+				t.Errorf("phase proceed = %v, want false", proceed)
+			}
+		})
+	}
+}
@@ -0,0 +1,145 @@
+// SPDX-License-Identifier: MIT
+
+package phases
+
+import (
+	"fmt"
+
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	"github.com/nukleros/operator-builder-tools/pkg/controller/plan"
+	"github.com/nukleros/operator-builder-tools/pkg/controller/workload"
+	"github.com/nukleros/operator-builder-tools/pkg/resources"
+	"github.com/nukleros/operator-builder-tools/pkg/status"
+)
+
+// PruneResourcesPhase deletes the child resources of a workload which were previously created but are no
+// longer desired.  The child resource conditions stored on the workload status act as the inventory of
+// resources which have been applied, so that anything in the inventory which is no longer returned from
+// the reconciler is removed from the cluster and from the inventory.  The workload must implement
+// ChildResourceConditionRemover, otherwise pruned resources would remain in the inventory and be
+// looked up again on every reconciliation.
+func PruneResourcesPhase(r workload.Reconciler, req *workload.Request, _ ...ResourceOption) (bool, error) {
+	remover, ok := req.Workload.(workload.ChildResourceConditionRemover)
+	if !ok {
+		return false, fmt.Errorf(
+			"%w; kind %s must implement ChildResourceConditionRemover to prune resources",
+			workload.ErrInvalidWorkload,
+			req.Workload.GetWorkloadGVK().Kind,
+		)
+	}
+
+	// get the resources in memory
+	desiredResources, err := workload.GetDesiredResources(r, req)
+	if err != nil {
+		return false, fmt.Errorf("unable to retrieve resources, %w", err)
+	}
+
+	var pruned bool
+
+	for _, child := range req.Workload.GetChildResourceConditions() {
+		if child == nil || inventoryContains(desiredResources, child) {
+			continue
+		}
+
+		if err := pruneResource(r, req, child); err != nil {
+			return false, err
+		}
+
+		// the inventory is not modified when planning
+		if req.Plan != nil {
+			continue
+		}
+
+		remover.RemoveChildResourceCondition(child)
+
+		pruned = true
+	}
+
+	if !pruned {
+		return true, nil
+	}
+
+	if err := r.Status().Update(req.Context, req.Workload); err != nil {
+		return false, fmt.Errorf("unable to update Resource Conditions for %s, %w", req.Workload.GetWorkloadGVK().Kind, err)
+	}
+
+	return true, nil
+}
+
+// pruneResource deletes a single child resource from the cluster.  Resources which have
+// already been deleted, or which are now controlled by another parent, are left alone.
+func pruneResource(r workload.Reconciler, req *workload.Request, child *status.ChildResource) error {
+	resource := childResourceObject(child)
+
+	clusterResource, err := resources.Get(r, req, resource)
+	if err != nil {
+		return fmt.Errorf("unable to retrieve resource %s for pruning, %w", child.Name, err)
+	}
+
+	if clusterResource == nil {
+		return nil
+	}
+
+	// do not delete a resource which has been adopted by a different controller
+	if owner := metav1.GetControllerOf(clusterResource); owner != nil && owner.UID != req.Workload.GetUID() {
+		r.GetLogger().V(2).Info("skipping prune of resource controlled by another owner", resources.MessageFor(resource)...)
+
+		return nil
+	}
+
+	if req.Plan != nil {
+		req.Plan.Record(plan.ActionPrune, clusterResource, nil)
+
+		return nil
+	}
+
+	if err := resources.Delete(
+		r,
+		req,
+		clusterResource,
+		client.PropagationPolicy(metav1.DeletePropagationBackground),
+	); err != nil {
+		return fmt.Errorf("unable to prune resource %s, %w", child.Name, err)
+	}
+
+	// add the deleted event
+	status.Deleted.RegisterAction(r.GetEventRecorder(), clusterResource, req.Workload)
+
+	return nil
+}
+
+// inventoryContains determines if a child resource from the inventory is still a desired resource.  The
+// version is not compared so that a resource which has moved to a new api version is not pruned.
+func inventoryContains(desiredResources []client.Object, child *status.ChildResource) bool {
+	for _, desired := range desiredResources {
+		gvk := desired.GetObjectKind().GroupVersionKind()
+
+		if gvk.Group == child.Group &&
+			gvk.Kind == child.Kind &&
+			desired.GetName() == child.Name &&
+			desired.GetNamespace() == child.Namespace {
+			return true
+		}
+	}
+
+	return false
+}
+
+// childResourceObject returns an object stub which can be used to look up a child resource
+// from the inventory.
+func childResourceObject(child *status.ChildResource) *unstructured.Unstructured {
+	resource := &unstructured.Unstructured{}
+	resource.SetGroupVersionKind(schema.GroupVersionKind{
+		Group:   child.Group,
+		Version: child.Version,
+		Kind:    child.Kind,
+	})
+	resource.SetName(child.Name)
+	resource.SetNamespace(child.Namespace)
+
+	return resource
+}
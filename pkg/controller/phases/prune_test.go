@@ -0,0 +1,132 @@
+/*
+	SPDX-License-Identifier: MIT
+*/
+
+package phases
+
+import (
+	"context"
+	"reflect"
+	"strings"
+	"testing"
+
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	"github.com/nukleros/operator-builder-tools/pkg/controller/plan"
+	"github.com/nukleros/operator-builder-tools/pkg/controller/workload"
+	"github.com/nukleros/operator-builder-tools/pkg/status"
+)
+
+func TestPruneResourcesPhase(t *testing.T) {
+	t.Parallel()
+
+	desired := newChildResource("v1", "ConfigMap", "desired", "", nil)
+	existing := []client.Object{
+		newChildResource("v1", "ConfigMap", "desired", testWorkloadUID, nil),
+		newChildResource("v1", "ConfigMap", "stale", testWorkloadUID, nil),
+		newChildResource("v1", "ConfigMap", "adopted", "other-uid", nil),
+	}
+
+	tests := []struct {
+		name           string
+		plan           bool
+		wantDeleted    []string
+		wantPolicies   map[string]metav1.DeletionPropagation
+		wantConditions []string
+		wantEvents     int
+		wantChanges    []*plan.Change
+	}{
+		{
+			name:           "resources which are no longer desired are pruned",
+			wantDeleted:    []string{"stale"},
+			wantPolicies:   map[string]metav1.DeletionPropagation{"stale": metav1.DeletePropagationBackground},
+			wantConditions: []string{"desired"},
+			wantEvents:     1,
+		},
+		{
+			name:           "resources are recorded rather than pruned when planning",
+			plan:           true,
+			wantDeleted:    []string{},
+			wantPolicies:   map[string]metav1.DeletionPropagation{},
+			wantConditions: []string{"desired", "stale", "adopted"},
+			wantChanges: []*plan.Change{
+				{Version: "v1", Kind: "ConfigMap", Name: "stale", Namespace: "default", Action: plan.ActionPrune},
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		tt := tt
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			r := newTestReconciler([]client.Object{desired}, existing...)
+			req := &workload.Request{
+				Context: context.Background(),
+				Workload: newTestWorkload(
+					status.ToCommonResource(existing[0]),
+					status.ToCommonResource(existing[1]),
+					status.ToCommonResource(existing[2]),
+				),
+			}
+
+			if tt.plan {
+				req.Plan = plan.New()
+			}
+
+			proceed, err := PruneResourcesPhase(r, req)
+			if err != nil {
+				t.Fatalf("PruneResourcesPhase() error = %v", err)
+			}
+
+			if !proceed {
+				t.Errorf("PruneResourcesPhase() = %v, want true", proceed)
+			}
+
+			if r.deleted == nil {
+				r.deleted = []string{}
+			}
+
+			if !reflect.DeepEqual(r.deleted, tt.wantDeleted) {
+				t.Errorf("PruneResourcesPhase() deleted = %v, want %v", r.deleted, tt.wantDeleted)
+			}
+
+			if !reflect.DeepEqual(r.policies, tt.wantPolicies) {
+				t.Errorf("PruneResourcesPhase() policies = %v, want %v", r.policies, tt.wantPolicies)
+			}
+
+			for _, object := range existing {
+				remaining := r.exists(t, object)
+				wantRemaining := !containsString(tt.wantDeleted, object.GetName())
+
+				if remaining != wantRemaining {
+					t.Errorf("PruneResourcesPhase() resource %s remaining = %v, want %v", object.GetName(), remaining, wantRemaining)
+				}
+			}
+
+			conditions := []string{}
+			for _, condition := range req.Workload.GetChildResourceConditions() {
+				conditions = append(conditions, condition.Name)
+			}
+
+			if !reflect.DeepEqual(conditions, tt.wantConditions) {
+				t.Errorf("PruneResourcesPhase() conditions = %v, want %v", conditions, tt.wantConditions)
+			}
+
+			if len(r.recorder.Events) != tt.wantEvents {
+				t.Fatalf("PruneResourcesPhase() events = %d, want %d", len(r.recorder.Events), tt.wantEvents)
+			}
+
+			for i := 0; i < tt.wantEvents; i++ {
+				if event := <-r.recorder.Events; !strings.HasPrefix(event, "Normal "+status.DeletedString) {
+					t.Errorf("PruneResourcesPhase() event = %s, want %s event", event, status.DeletedString)
+				}
+			}
+
+			if tt.plan && !reflect.DeepEqual(req.Plan.Changes, tt.wantChanges) {
+				t.Errorf("PruneResourcesPhase() changes = %v, want %v", req.Plan.Changes, tt.wantChanges)
+			}
+		})
+	}
+}
@@ -0,0 +1,121 @@
+// SPDX-License-Identifier: MIT
+
+package phases
+
+import (
+	"errors"
+	"fmt"
+
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	"github.com/nukleros/operator-builder-tools/pkg/controller/workload"
+	"github.com/nukleros/operator-builder-tools/pkg/resources"
+	"github.com/nukleros/operator-builder-tools/pkg/status"
+)
+
+const (
+	// RecreatePolicyAnnotation is the annotation which defines what happens to a child resource when
+	// an update is rejected because it changes an immutable field.  Setting the annotation to a policy
+	// other than Never opts the child resource in to being recreated.  When the annotation is not set,
+	// child resources are only recreated when requested with ResourceOptionWithRecreate, with the
+	// exception of jobs, which are always recreated so that they run again with a changed pod template.
+	RecreatePolicyAnnotation = "operator-builder.nukleros.io/recreate-policy"
+
+	// RecreatePolicyNever never recreates the child resource and surfaces the update error instead.
+	// This is the default.
+	RecreatePolicyNever = "Never"
+
+	// RecreatePolicyBackground recreates the child resource and deletes its dependents in the background.
+	RecreatePolicyBackground = "Background"
+
+	// RecreatePolicyForeground recreates the child resource once its dependents have been deleted.
+	RecreatePolicyForeground = "Foreground"
+
+	// RecreatePolicyOrphan recreates the child resource and orphans its dependents so that they may be
+	// adopted by the new resource.
+	RecreatePolicyOrphan = "Orphan"
+)
+
+// ErrResourceRecreating is returned when a child resource has been deleted so that it may be recreated,
+// but the deletion has not completed.
+var ErrResourceRecreating = errors.New("resource is being recreated")
+
+// recreatePolicies are the recreate policies of the kinds whose dependents should not be deleted in the
+// background when they are recreated without a RecreatePolicyAnnotation.  Pods of a StatefulSet are
+// orphaned so that they, along with their volumes, are adopted by the new StatefulSet.
+//
+//nolint:gochecknoglobals
+var recreatePolicies = map[schema.GroupKind]string{
+	{Group: "apps", Kind: "StatefulSet"}: RecreatePolicyOrphan,
+}
+
+// recreatePolicyFor returns the recreate policy of a child resource.
+func recreatePolicyFor(resource client.Object, options ...ResourceOption) string {
+	switch policy := resource.GetAnnotations()[RecreatePolicyAnnotation]; policy {
+	case RecreatePolicyNever, RecreatePolicyBackground, RecreatePolicyForeground, RecreatePolicyOrphan:
+		return policy
+	}
+
+	groupKind := resource.GetObjectKind().GroupVersionKind().GroupKind()
+	isJob := groupKind == schema.GroupKind{Group: "batch", Kind: resources.JobKind}
+
+	if !isJob && !hasResourceOption(ResourceOptionWithRecreate, options...) {
+		return RecreatePolicyNever
+	}
+
+	if policy, ok := recreatePolicies[groupKind]; ok {
+		return policy
+	}
+
+	return RecreatePolicyBackground
+}
+
+// jobTemplateChanged determines if a job should be recreated because its pod template has changed.
+func jobTemplateChanged(desiredResource, currentResource client.Object) bool {
+	return resources.JobTemplateChanged(desiredResource, currentResource) && recreatePolicyFor(desiredResource) != RecreatePolicyNever
+}
+
+// shouldRecreate determines if a child resource should be recreated after an update of it failed.
+func shouldRecreate(resource client.Object, err error, options ...ResourceOption) bool {
+	return errors.Is(err, resources.ErrImmutableField) && recreatePolicyFor(resource, options...) != RecreatePolicyNever
+}
+
+// recreate deletes a child resource using the propagation policy of its recreate policy and creates it
+// again once the deletion has completed.  An ErrResourceRecreating error is returned while the deletion
+// is in progress.
+func recreate(
+	r workload.Reconciler,
+	req *workload.Request,
+	desiredResource, currentResource client.Object,
+	options ...ResourceOption,
+) error {
+	// only request the deletion once.  The recreate policies are named after the propagation policies.
+	if currentResource.GetDeletionTimestamp().IsZero() {
+		propagationPolicy := metav1.DeletionPropagation(recreatePolicyFor(desiredResource, options...))
+
+		if err := resources.Delete(r, req, currentResource, client.PropagationPolicy(propagationPolicy)); err != nil {
+			return fmt.Errorf("unable to delete resource %s for recreation, %w", desiredResource.GetName(), err)
+		}
+
+		// add the recreated event
+		status.Recreated.RegisterAction(r.GetEventRecorder(), desiredResource, req.Workload)
+	}
+
+	// create the resource immediately if the deletion has already completed
+	clusterResource, err := resources.Get(r, req, desiredResource)
+	if err != nil {
+		return fmt.Errorf("unable to retrieve resource %s, %w", desiredResource.GetName(), err)
+	}
+
+	if clusterResource != nil {
+		return fmt.Errorf("%w; waiting for %s to be deleted", ErrResourceRecreating, desiredResource.GetName())
+	}
+
+	if hasResourceOption(ResourceOptionWithServerSideApply, options...) {
+		return apply(r, req, desiredResource, nil, hasResourceOption(ResourceOptionWithForceConflicts, options...))
+	}
+
+	return create(r, req, desiredResource)
+}
@@ -0,0 +1,95 @@
+/*
+	SPDX-License-Identifier: MIT
+*/
+
+package phases
+
+import (
+	"testing"
+
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+)
+
+func Test_recreatePolicyFor(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name       string
+		gvk        schema.GroupVersionKind
+		annotation string
+		options    []ResourceOption
+		want       string
+	}{
+		{
+			name: "resource is not recreated by default",
+			gvk:  schema.GroupVersionKind{Group: "apps", Version: "v1", Kind: "Deployment"},
+			want: RecreatePolicyNever,
+		},
+		{
+			name: "service is not recreated by default",
+			gvk:  schema.GroupVersionKind{Version: "v1", Kind: "Service"},
+			want: RecreatePolicyNever,
+		},
+		{
+			name: "job is recreated by default",
+			gvk:  schema.GroupVersionKind{Group: "batch", Version: "v1", Kind: "Job"},
+			want: RecreatePolicyBackground,
+		},
+		{
+			name:       "job may opt out with the annotation",
+			gvk:        schema.GroupVersionKind{Group: "batch", Version: "v1", Kind: "Job"},
+			annotation: RecreatePolicyNever,
+			want:       RecreatePolicyNever,
+		},
+		{
+			name:    "resource is recreated in the background with the recreate option",
+			gvk:     schema.GroupVersionKind{Group: "apps", Version: "v1", Kind: "Deployment"},
+			options: []ResourceOption{ResourceOptionWithRecreate},
+			want:    RecreatePolicyBackground,
+		},
+		{
+			name:    "statefulset orphans its pods with the recreate option",
+			gvk:     schema.GroupVersionKind{Group: "apps", Version: "v1", Kind: "StatefulSet"},
+			options: []ResourceOption{ResourceOptionWithRecreate},
+			want:    RecreatePolicyOrphan,
+		},
+		{
+			name:       "resource may opt in with the annotation",
+			gvk:        schema.GroupVersionKind{Group: "apps", Version: "v1", Kind: "Deployment"},
+			annotation: RecreatePolicyForeground,
+			want:       RecreatePolicyForeground,
+		},
+		{
+			name:       "resource may opt out of the recreate option with the annotation",
+			gvk:        schema.GroupVersionKind{Group: "apps", Version: "v1", Kind: "Deployment"},
+			annotation: RecreatePolicyNever,
+			options:    []ResourceOption{ResourceOptionWithRecreate},
+			want:       RecreatePolicyNever,
+		},
+		{
+			name:       "invalid annotation is ignored",
+			gvk:        schema.GroupVersionKind{Group: "apps", Version: "v1", Kind: "Deployment"},
+			annotation: "Always",
+			want:       RecreatePolicyNever,
+		},
+	}
+
+	for _, tt := range tests {
+		tt := tt
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			resource := &unstructured.Unstructured{}
+			resource.SetGroupVersionKind(tt.gvk)
+
+			if tt.annotation != "" {
+				resource.SetAnnotations(map[string]string{RecreatePolicyAnnotation: tt.annotation})
+			}
+
+			if got := recreatePolicyFor(resource, tt.options...); got != tt.want {
+				t.Errorf("recreatePolicyFor() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
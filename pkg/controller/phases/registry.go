@@ -33,6 +33,7 @@ func (registry *Registry) Register(name string, definition HandlerFunc, event Li
 	phase := &Phase{
 		Name:       name,
 		definition: definition,
+		planned:    supportsPlanning(definition),
 	}
 
 	for _, option := range options {
@@ -93,6 +94,14 @@ func (registry *Registry) Execute(r workload.Reconciler, req *workload.Request,
 			"phase", phase.Name,
 		)
 
+		// phases which would persist their changes are not executed when planning
+		if req.Plan != nil && !phase.planned {
+			req.Log.V(2).Info("skipping phase which does not support planning", "phase", phase.Name)
+			req.Plan.Skip(phase.Name)
+
+			continue
+		}
+
 		proceed, err := phase.definition(r, req, phase.resourceOptions...)
 		result, err := phase.handlePhaseExit(r, req, proceed, err)
 
@@ -108,6 +117,10 @@ func (registry *Registry) Execute(r workload.Reconciler, req *workload.Request,
 			}
 
 			if !proceed {
+				if req.Plan != nil {
+					req.Plan.Stop(phase.Name)
+				}
+
 				return result, nil
 			}
 		}
@@ -118,7 +131,7 @@ func (registry *Registry) Execute(r workload.Reconciler, req *workload.Request,
 		)
 	}
 
-	return ctrl.Result{}, nil
+	return ctrl.Result{RequeueAfter: req.RequeueAfter}, nil
 }
 
 // getPhases returns the phases for a given lifecycle event.
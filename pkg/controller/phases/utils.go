@@ -3,7 +3,11 @@
 package phases
 
 import (
+	"errors"
 	"strings"
+
+	"github.com/nukleros/operator-builder-tools/pkg/controller/workload"
+	"github.com/nukleros/operator-builder-tools/pkg/resources"
 )
 
 // optimisticLockErrorMsg is the error we see from the k8s api when optimistic locking occurs.
@@ -13,3 +17,17 @@ const optimisticLockErrorMsg = "the object has been modified; please apply your
 func IsOptimisticLockError(err error) bool {
 	return strings.Contains(err.Error(), optimisticLockErrorMsg)
 }
+
+// IsPendingError checks to see if the error signals that a phase is waiting on a condition to be
+// met rather than a failure of the phase.  Pending errors leave the phase in a pending state and
+// the message of the error is surfaced on the phase condition.
+func IsPendingError(err error) bool {
+	return errors.Is(err, workload.ErrCollectionNotFound) || errors.Is(err, ErrUnmetDependencies)
+}
+
+// IsTerminalError checks to see if the error signals that a child resource has failed and will not
+// become ready without intervention, such as a job which has exceeded its backoff limit.  Terminal
+// errors fail the phase without requeueing, as retrying would not change the outcome.
+func IsTerminalError(err error) bool {
+	return errors.Is(err, resources.ErrResourceFailed)
+}
@@ -0,0 +1,115 @@
+// SPDX-License-Identifier: MIT
+
+package plan
+
+import (
+	"encoding/json"
+	"fmt"
+	"reflect"
+	"sort"
+	"strings"
+)
+
+// ignoredPaths are the paths which are owned by the server and which are never considered
+// when calculating differences.
+//
+//nolint:gochecknoglobals
+var ignoredPaths = map[string]bool{
+	"status":                     true,
+	"metadata.managedFields":     true,
+	"metadata.resourceVersion":   true,
+	"metadata.generation":        true,
+	"metadata.uid":               true,
+	"metadata.creationTimestamp": true,
+	"metadata.selfLink":          true,
+}
+
+// FieldDiff is the difference of a single field between a current and desired resource.  A nil
+// current value represents a field which would be added and a nil desired value represents a
+// field which would be removed.
+type FieldDiff struct {
+	// Path defines the dot-separated path of the field.
+	Path string `json:"path"`
+
+	// Current defines the value of the field in the cluster.
+	Current any `json:"current,omitempty"`
+
+	// Desired defines the value the field would be set to.
+	Desired any `json:"desired,omitempty"`
+}
+
+// Diff returns the field-level differences between the current and desired representation of a
+// resource, sorted by path.  Lists are compared as a whole.
+func Diff(current, desired map[string]any) []FieldDiff {
+	diffs := diffFields("", current, desired)
+
+	sort.Slice(diffs, func(i, j int) bool {
+		return diffs[i].Path < diffs[j].Path
+	})
+
+	return diffs
+}
+
+// diffFields recursively calculates the differences between two objects.
+func diffFields(prefix string, current, desired map[string]any) []FieldDiff {
+	diffs := []FieldDiff{}
+
+	keys := map[string]bool{}
+	for key := range current {
+		keys[key] = true
+	}
+
+	for key := range desired {
+		keys[key] = true
+	}
+
+	for key := range keys {
+		path := key
+		if prefix != "" {
+			path = prefix + "." + key
+		}
+
+		if ignoredPaths[path] {
+			continue
+		}
+
+		currentValue, desiredValue := current[key], desired[key]
+
+		currentMap, currentIsMap := currentValue.(map[string]any)
+		desiredMap, desiredIsMap := desiredValue.(map[string]any)
+
+		if currentIsMap && desiredIsMap {
+			diffs = append(diffs, diffFields(path, currentMap, desiredMap)...)
+
+			continue
+		}
+
+		if !reflect.DeepEqual(currentValue, desiredValue) {
+			diffs = append(diffs, FieldDiff{Path: path, Current: currentValue, Desired: desiredValue})
+		}
+	}
+
+	return diffs
+}
+
+// String returns the human-readable representation of a field difference.
+func (diff FieldDiff) String() string {
+	switch {
+	case diff.Current == nil:
+		return fmt.Sprintf("+ %s: %s", diff.Path, renderValue(diff.Desired))
+	case diff.Desired == nil:
+		return fmt.Sprintf("- %s: %s", diff.Path, renderValue(diff.Current))
+	default:
+		return fmt.Sprintf("~ %s: %s -> %s", diff.Path, renderValue(diff.Current), renderValue(diff.Desired))
+	}
+}
+
+// renderValue renders a field value as compact JSON.
+func renderValue(value any) string {
+	rendered, err := json.Marshal(value)
+	if err != nil {
+		return strings.TrimSpace(fmt.Sprintf("%v", value))
+	}
+
+	return string(rendered)
+}
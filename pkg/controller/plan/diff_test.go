@@ -0,0 +1,119 @@
+/*
+	SPDX-License-Identifier: MIT
+*/
+
+package plan_test
+
+import (
+	"reflect"
+	"testing"
+
+	"github.com/nukleros/operator-builder-tools/pkg/controller/plan"
+)
+
+func TestDiff(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name    string
+		current map[string]any
+		desired map[string]any
+		want    []plan.FieldDiff
+	}{
+		{
+			name:    "equal objects have no differences",
+			current: map[string]any{"spec": map[string]any{"replicas": int64(1)}},
+			desired: map[string]any{"spec": map[string]any{"replicas": int64(1)}},
+			want:    []plan.FieldDiff{},
+		},
+		{
+			name:    "missing current object adds every top level field",
+			desired: map[string]any{"kind": "ConfigMap", "data": map[string]any{"key": "value"}},
+			want: []plan.FieldDiff{
+				{Path: "data", Desired: map[string]any{"key": "value"}},
+				{Path: "kind", Desired: "ConfigMap"},
+			},
+		},
+		{
+			name:    "changed nested field is reported by its path",
+			current: map[string]any{"spec": map[string]any{"replicas": int64(1), "paused": false}},
+			desired: map[string]any{"spec": map[string]any{"replicas": int64(3), "paused": false}},
+			want:    []plan.FieldDiff{{Path: "spec.replicas", Current: int64(1), Desired: int64(3)}},
+		},
+		{
+			name:    "removed field has no desired value",
+			current: map[string]any{"data": map[string]any{"old": "value"}},
+			desired: map[string]any{"data": map[string]any{}},
+			want:    []plan.FieldDiff{{Path: "data.old", Current: "value"}},
+		},
+		{
+			name:    "lists are compared as a whole",
+			current: map[string]any{"args": []any{"a", "b"}},
+			desired: map[string]any{"args": []any{"a", "c"}},
+			want:    []plan.FieldDiff{{Path: "args", Current: []any{"a", "b"}, Desired: []any{"a", "c"}}},
+		},
+		{
+			name: "server owned fields are ignored",
+			current: map[string]any{
+				"metadata": map[string]any{"name": "app", "resourceVersion": "1", "uid": "abc"},
+				"status":   map[string]any{"ready": true},
+			},
+			desired: map[string]any{"metadata": map[string]any{"name": "app"}},
+			want:    []plan.FieldDiff{},
+		},
+		{
+			name:    "field changed from an object to a value is replaced",
+			current: map[string]any{"value": map[string]any{"nested": "a"}},
+			desired: map[string]any{"value": "a"},
+			want:    []plan.FieldDiff{{Path: "value", Current: map[string]any{"nested": "a"}, Desired: "a"}},
+		},
+	}
+
+	for _, tt := range tests {
+		tt := tt
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			if got := plan.Diff(tt.current, tt.desired); !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("Diff() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestFieldDiff_String(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name string
+		diff plan.FieldDiff
+		want string
+	}{
+		{
+			name: "added field",
+			diff: plan.FieldDiff{Path: "spec.replicas", Desired: int64(3)},
+			want: "+ spec.replicas: 3",
+		},
+		{
+			name: "removed field",
+			diff: plan.FieldDiff{Path: "data.key", Current: "value"},
+			want: `- data.key: "value"`,
+		},
+		{
+			name: "changed field",
+			diff: plan.FieldDiff{Path: "spec.args", Current: []any{"a"}, Desired: []any{"a", "b"}},
+			want: `~ spec.args: ["a"] -> ["a","b"]`,
+		},
+	}
+
+	for _, tt := range tests {
+		tt := tt
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			if got := tt.diff.String(); got != tt.want {
+				t.Errorf("FieldDiff.String() = %q, want %q", got, tt.want)
+			}
+		})
+	}
+}
@@ -0,0 +1,180 @@
+// SPDX-License-Identifier: MIT
+
+package plan
+
+import (
+	"encoding/json"
+	"fmt"
+	"strings"
+	"sync"
+
+	"sigs.k8s.io/controller-runtime/pkg/client"
+)
+
+// Action defines the action that would be taken against a child resource.
+type Action string
+
+const (
+	ActionCreate   Action = "create"
+	ActionUpdate   Action = "update"
+	ActionRecreate Action = "recreate"
+	ActionNoop     Action = "noop"
+	ActionPrune    Action = "prune"
+)
+
+// Plan is the report of the changes which would be made to the child resources of a workload
+// if the phases of the workload were executed.
+type Plan struct {
+	// Changes defines the planned change for each child resource, in the order in which
+	// the changes were planned.
+	Changes []*Change `json:"changes"`
+
+	// StoppedAt defines the name of the phase at which planning stopped because the phase was not able
+	// to proceed, such as a phase which is waiting on dependencies.  The changes of the phases which
+	// follow it are not planned, so the plan is incomplete when it is set.
+	StoppedAt string `json:"stoppedAt,omitempty"`
+
+	// SkippedPhases defines the names of the phases which were not executed because they do not
+	// support planning.  Any changes made by those phases are not included in the plan.
+	SkippedPhases []string `json:"skippedPhases,omitempty"`
+
+	lock sync.Mutex
+}
+
+// Change is a planned change for an individual child resource.
+type Change struct {
+	// Group defines the API Group of the resource.
+	Group string `json:"group"`
+
+	// Version defines the API Version of the resource.
+	Version string `json:"version"`
+
+	// Kind defines the kind of the resource.
+	Kind string `json:"kind"`
+
+	// Name defines the name of the resource from the metadata.name field.
+	Name string `json:"name"`
+
+	// Namespace defines the namespace in which this resource exists in.
+	Namespace string `json:"namespace,omitempty"`
+
+	// Action defines the action that would be taken against the resource.
+	Action Action `json:"action"`
+
+	// Diff defines the field-level differences between the current and desired resource.
+	Diff []FieldDiff `json:"diff,omitempty"`
+}
+
+// New creates and returns a new empty Plan.
+func New() *Plan {
+	return &Plan{Changes: []*Change{}}
+}
+
+// Record records a planned change for a resource.  It is safe to call concurrently.
+func (plan *Plan) Record(action Action, resource client.Object, diff []FieldDiff) {
+	gvk := resource.GetObjectKind().GroupVersionKind()
+
+	plan.lock.Lock()
+	defer plan.lock.Unlock()
+
+	plan.Changes = append(plan.Changes, &Change{
+		Group:     gvk.Group,
+		Version:   gvk.Version,
+		Kind:      gvk.Kind,
+		Name:      resource.GetName(),
+		Namespace: resource.GetNamespace(),
+		Action:    action,
+		Diff:      diff,
+	})
+}
+
+// Stop records the phase at which planning stopped.
+func (plan *Plan) Stop(phase string) {
+	plan.lock.Lock()
+	defer plan.lock.Unlock()
+
+	plan.StoppedAt = phase
+}
+
+// Skip records a phase which was not executed because it does not support planning.
+func (plan *Plan) Skip(phase string) {
+	plan.lock.Lock()
+	defer plan.lock.Unlock()
+
+	plan.SkippedPhases = append(plan.SkippedPhases, phase)
+}
+
+// IsComplete returns whether all phases were planned.
+func (plan *Plan) IsComplete() bool {
+	plan.lock.Lock()
+	defer plan.lock.Unlock()
+
+	return plan.StoppedAt == "" && len(plan.SkippedPhases) == 0
+}
+
+// HasChanges returns whether the plan contains any action other than a noop.
+func (plan *Plan) HasChanges() bool {
+	plan.lock.Lock()
+	defer plan.lock.Unlock()
+
+	for _, change := range plan.Changes {
+		if change.Action != ActionNoop {
+			return true
+		}
+	}
+
+	return false
+}
+
+// JSON returns the JSON representation of the plan.
+func (plan *Plan) JSON() ([]byte, error) {
+	plan.lock.Lock()
+	defer plan.lock.Unlock()
+
+	output, err := json.MarshalIndent(plan, "", "  ")
+	if err != nil {
+		return nil, fmt.Errorf("unable to render plan as json, %w", err)
+	}
+
+	return output, nil
+}
+
+// String returns the human-readable text representation of the plan.
+func (plan *Plan) String() string {
+	plan.lock.Lock()
+	defer plan.lock.Unlock()
+
+	var output strings.Builder
+
+	for _, change := range plan.Changes {
+		fmt.Fprintf(&output, "%s %s\n", change.Action, change)
+
+		for _, diff := range change.Diff {
+			fmt.Fprintf(&output, "  %s\n", diff)
+		}
+	}
+
+	if len(plan.SkippedPhases) > 0 {
+		fmt.Fprintf(&output, "phases [%s] do not support planning and were skipped\n", strings.Join(plan.SkippedPhases, ", "))
+	}
+
+	if plan.StoppedAt != "" {
+		fmt.Fprintf(&output, "planning stopped at phase %s; changes of later phases are not planned\n", plan.StoppedAt)
+	}
+
+	return output.String()
+}
+
+// String returns the resource identity of a planned change.
+func (change *Change) String() string {
+	apiVersion := change.Version
+	if change.Group != "" {
+		apiVersion = change.Group + "/" + change.Version
+	}
+
+	if change.Namespace == "" {
+		return fmt.Sprintf("%s %s %s", apiVersion, change.Kind, change.Name)
+	}
+
+	return fmt.Sprintf("%s %s %s/%s", apiVersion, change.Kind, change.Namespace, change.Name)
+}
@@ -0,0 +1,102 @@
+/*
+	SPDX-License-Identifier: MIT
+*/
+
+package plan_test
+
+import (
+	"testing"
+
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+
+	"github.com/nukleros/operator-builder-tools/pkg/controller/plan"
+)
+
+func newPlanResource(apiVersion, kind, namespace, name string) *unstructured.Unstructured {
+	resource := &unstructured.Unstructured{}
+	resource.SetAPIVersion(apiVersion)
+	resource.SetKind(kind)
+	resource.SetNamespace(namespace)
+	resource.SetName(name)
+
+	return resource
+}
+
+func TestPlan_String(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name         string
+		record       func(*plan.Plan)
+		want         string
+		wantChanges  bool
+		wantComplete bool
+	}{
+		{
+			name:         "empty plan",
+			want:         "",
+			wantComplete: true,
+		},
+		{
+			name: "noop change has no changes",
+			record: func(p *plan.Plan) {
+				p.Record(plan.ActionNoop, newPlanResource("v1", "Namespace", "", "app"), nil)
+			},
+			want:         "noop v1 Namespace app\n",
+			wantComplete: true,
+		},
+		{
+			name: "changes are listed with their differences",
+			record: func(p *plan.Plan) {
+				p.Record(plan.ActionCreate, newPlanResource("v1", "ConfigMap", "default", "config"), []plan.FieldDiff{
+					{Path: "data.key", Desired: "value"},
+				})
+				p.Record(plan.ActionUpdate, newPlanResource("apps/v1", "Deployment", "default", "app"), []plan.FieldDiff{
+					{Path: "spec.replicas", Current: int64(1), Desired: int64(3)},
+				})
+			},
+			want: "create v1 ConfigMap default/config\n" +
+				"  + data.key: \"value\"\n" +
+				"update apps/v1 Deployment default/app\n" +
+				"  ~ spec.replicas: 1 -> 3\n",
+			wantChanges:  true,
+			wantComplete: true,
+		},
+		{
+			name: "skipped and stopped phases are reported",
+			record: func(p *plan.Plan) {
+				p.Record(plan.ActionPrune, newPlanResource("v1", "Service", "default", "old"), nil)
+				p.Skip("Custom")
+				p.Stop("Check Ready")
+			},
+			want: "prune v1 Service default/old\n" +
+				"phases [Custom] do not support planning and were skipped\n" +
+				"planning stopped at phase Check Ready; changes of later phases are not planned\n",
+			wantChanges: true,
+		},
+	}
+
+	for _, tt := range tests {
+		tt := tt
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			p := plan.New()
+			if tt.record != nil {
+				tt.record(p)
+			}
+
+			if got := p.String(); got != tt.want {
+				t.Errorf("Plan.String() = %q, want %q", got, tt.want)
+			}
+
+			if got := p.HasChanges(); got != tt.wantChanges {
+				t.Errorf("Plan.HasChanges() = %v, want %v", got, tt.wantChanges)
+			}
+
+			if got := p.IsComplete(); got != tt.wantComplete {
+				t.Errorf("Plan.IsComplete() = %v, want %v", got, tt.wantComplete)
+			}
+		})
+	}
+}
@@ -4,7 +4,9 @@ package predicates
 
 import (
 	"fmt"
+	"reflect"
 
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
 	"sigs.k8s.io/controller-runtime/pkg/client"
 	"sigs.k8s.io/controller-runtime/pkg/event"
 	"sigs.k8s.io/controller-runtime/pkg/predicate"
@@ -55,6 +57,41 @@ func WorkloadPredicates() predicate.Predicate {
 	}
 }
 
+// DependencyPredicates returns the filters which are used to filter out the common events of a
+// dependency prior to requeueing the workloads which depend on it.  Only changes to the status or
+// labels of a dependency can change whether a dependency is satisfied.
+func DependencyPredicates() predicate.Predicate {
+	return predicate.Funcs{
+		UpdateFunc: func(e event.UpdateEvent) bool {
+			if !reflect.DeepEqual(e.ObjectOld.GetLabels(), e.ObjectNew.GetLabels()) {
+				return true
+			}
+
+			return !reflect.DeepEqual(statusOf(e.ObjectOld), statusOf(e.ObjectNew))
+		},
+		CreateFunc: func(e event.CreateEvent) bool {
+			return true
+		},
+		DeleteFunc: func(e event.DeleteEvent) bool {
+			return true
+		},
+		GenericFunc: func(e event.GenericEvent) bool {
+			return false
+		},
+	}
+}
+
+// statusOf returns the status field of an object.  It returns nil if the object is not
+// unstructured or does not have a status.
+func statusOf(object client.Object) interface{} {
+	unstructuredObject, ok := object.(*unstructured.Unstructured)
+	if !ok {
+		return nil
+	}
+
+	return unstructuredObject.Object["status"]
+}
+
 // needsReconciliation performs some simple checks and returns whether or not a
 // resource needs to be updated.
 func needsReconciliation(r workload.Reconciler, req *workload.Request, existing, requested client.Object) bool {
@@ -95,7 +132,7 @@ func needsReconciliation(r workload.Reconciler, req *workload.Request, existing,
 
 // GetDesiredObject returns the desired object from a list stored in memory.
 func GetDesiredObject(r workload.Reconciler, req *workload.Request, compared client.Object) (client.Object, error) {
-	desired, err := r.GetResources(req)
+	desired, err := workload.GetDesiredResources(r, req)
 	if err != nil {
 		return nil, fmt.Errorf("unable to get resources, %w", err)
 	}
@@ -0,0 +1,113 @@
+/*
+	SPDX-License-Identifier: MIT
+*/
+
+package predicates_test
+
+import (
+	"testing"
+
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"sigs.k8s.io/controller-runtime/pkg/event"
+
+	"github.com/nukleros/operator-builder-tools/pkg/controller/predicates"
+)
+
+func newDependency(labels map[string]string, spec, status map[string]interface{}) *unstructured.Unstructured {
+	dependency := &unstructured.Unstructured{Object: map[string]interface{}{}}
+	dependency.SetAPIVersion("data.example.io/v1")
+	dependency.SetKind("Database")
+	dependency.SetName("primary")
+	dependency.SetNamespace("default")
+	dependency.SetLabels(labels)
+
+	if spec != nil {
+		dependency.Object["spec"] = spec
+	}
+
+	if status != nil {
+		dependency.Object["status"] = status
+	}
+
+	return dependency
+}
+
+func TestDependencyPredicates_Update(t *testing.T) {
+	t.Parallel()
+
+	old := newDependency(
+		map[string]string{"tier": "storage"},
+		map[string]interface{}{"replicas": int64(1)},
+		map[string]interface{}{"created": false},
+	)
+
+	tests := []struct {
+		name string
+		new  *unstructured.Unstructured
+		want bool
+	}{
+		{
+			name: "status change requeues dependents",
+			new: newDependency(
+				map[string]string{"tier": "storage"},
+				map[string]interface{}{"replicas": int64(1)},
+				map[string]interface{}{"created": true},
+			),
+			want: true,
+		},
+		{
+			name: "label change requeues dependents",
+			new: newDependency(
+				map[string]string{"tier": "cache"},
+				map[string]interface{}{"replicas": int64(1)},
+				map[string]interface{}{"created": false},
+			),
+			want: true,
+		},
+		{
+			name: "spec change does not requeue dependents",
+			new: newDependency(
+				map[string]string{"tier": "storage"},
+				map[string]interface{}{"replicas": int64(2)},
+				map[string]interface{}{"created": false},
+			),
+			want: false,
+		},
+		{
+			name: "unchanged dependency does not requeue dependents",
+			new:  old.DeepCopy(),
+			want: false,
+		},
+	}
+
+	for _, tt := range tests {
+		tt := tt
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			got := predicates.DependencyPredicates().Update(event.UpdateEvent{ObjectOld: old, ObjectNew: tt.new})
+			if got != tt.want {
+				t.Errorf("DependencyPredicates().Update() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestDependencyPredicates_Events(t *testing.T) {
+	t.Parallel()
+
+	dependency := newDependency(nil, nil, nil)
+	predicate := predicates.DependencyPredicates()
+
+	if !predicate.Create(event.CreateEvent{Object: dependency}) {
+		t.Errorf("DependencyPredicates().Create() = false, want true")
+	}
+
+	if !predicate.Delete(event.DeleteEvent{Object: dependency}) {
+		t.Errorf("DependencyPredicates().Delete() = false, want true")
+	}
+
+	if predicate.Generic(event.GenericEvent{Object: dependency}) {
+		t.Errorf("DependencyPredicates().Generic() = true, want false")
+	}
+}
@@ -0,0 +1,84 @@
+// SPDX-License-Identifier: MIT
+
+package reconcile
+
+import (
+	"context"
+	"fmt"
+
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/handler"
+	ctrlreconcile "sigs.k8s.io/controller-runtime/pkg/reconcile"
+
+	"github.com/nukleros/operator-builder-tools/pkg/controller/predicates"
+	"github.com/nukleros/operator-builder-tools/pkg/controller/workload"
+)
+
+// WatchCollection watches the collection kind of a component workload.  Changes to a collection
+// requeue every component workload whose collection reference matches the collection.
+func WatchCollection(
+	r workload.Reconciler,
+	req *workload.Request,
+	reference *workload.CollectionReference,
+) error {
+	if err := watchKind(
+		r,
+		reference.GroupVersionKind,
+		watchTypeCollection,
+		handler.EnqueueRequestsFromMapFunc(collectionMembers(r, req.Workload)),
+		predicates.WorkloadPredicates(),
+	); err != nil {
+		return fmt.Errorf("unable to watch collection, %w", err)
+	}
+
+	return nil
+}
+
+// collectionMembers returns a function which maps a collection to the reconcile requests of each
+// component workload, of the same kind as the component, which belongs to the collection.
+func collectionMembers(r workload.Reconciler, component workload.Workload) handler.MapFunc {
+	componentGVK := component.GetWorkloadGVK()
+
+	return func(ctx context.Context, collection client.Object) []ctrlreconcile.Request {
+		componentList := &unstructured.UnstructuredList{}
+		componentList.SetGroupVersionKind(componentGVK)
+
+		if err := r.List(ctx, componentList); err != nil {
+			r.GetLogger().Error(err, "unable to list components for collection", "collection", collection.GetName())
+
+			return nil
+		}
+
+		requests := []ctrlreconcile.Request{}
+
+		for i := range componentList.Items {
+			component, err := toWorkload(r, &componentList.Items[i])
+			if err != nil {
+				r.GetLogger().Error(err, "unable to determine collection for component", "component", componentList.Items[i].GetName())
+
+				continue
+			}
+
+			member, ok := component.(workload.CollectionMember)
+			if !ok || member.GetCollectionReference() == nil {
+				continue
+			}
+
+			matches, err := member.GetCollectionReference().Matches(collection)
+			if err != nil {
+				r.GetLogger().Error(err, "unable to match collection for component", "component", componentList.Items[i].GetName())
+
+				continue
+			}
+
+			if matches {
+				requests = append(requests, ctrlreconcile.Request{
+					NamespacedName: client.ObjectKeyFromObject(&componentList.Items[i]),
+				})
+			}
+		}
+
+		return requests
+	}
+}
@@ -0,0 +1,94 @@
+// SPDX-License-Identifier: MIT
+
+package reconcile
+
+import (
+	"context"
+	"fmt"
+
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/handler"
+	ctrlreconcile "sigs.k8s.io/controller-runtime/pkg/reconcile"
+
+	"github.com/nukleros/operator-builder-tools/pkg/controller/predicates"
+	"github.com/nukleros/operator-builder-tools/pkg/controller/workload"
+)
+
+// WatchDependencies watches the dependency kinds of a workload.  Status changes to a dependency requeue
+// every workload, of the same kind as the workload, which references the dependency and is still waiting
+// for its dependencies to be satisfied.
+func WatchDependencies(r workload.Reconciler, req *workload.Request) error {
+	for _, dependency := range workload.GetDependencyReferences(req.Workload) {
+		if err := watchKind(
+			r,
+			dependency.GroupVersionKind,
+			watchTypeDependency,
+			handler.EnqueueRequestsFromMapFunc(dependents(r, req.Workload)),
+			predicates.DependencyPredicates(),
+		); err != nil {
+			return fmt.Errorf("unable to watch dependency, %w", err)
+		}
+	}
+
+	return nil
+}
+
+// dependents returns a function which maps a dependency to the reconcile requests of each workload, of the
+// same kind as the dependent, which references the dependency and has unsatisfied dependencies.
+func dependents(r workload.Reconciler, dependent workload.Workload) handler.MapFunc {
+	dependentGVK := dependent.GetWorkloadGVK()
+
+	return func(ctx context.Context, dependency client.Object) []ctrlreconcile.Request {
+		dependentList := &unstructured.UnstructuredList{}
+		dependentList.SetGroupVersionKind(dependentGVK)
+
+		if err := r.List(ctx, dependentList); err != nil {
+			r.GetLogger().Error(err, "unable to list dependents for dependency", "dependency", dependency.GetName())
+
+			return nil
+		}
+
+		requests := []ctrlreconcile.Request{}
+
+		for i := range dependentList.Items {
+			candidate, err := toWorkload(r, &dependentList.Items[i])
+			if err != nil {
+				r.GetLogger().Error(err, "unable to determine dependencies for workload", "workload", dependentList.Items[i].GetName())
+
+				continue
+			}
+
+			// only requeue workloads which are waiting on their dependencies
+			if candidate.GetDependencyStatus() {
+				continue
+			}
+
+			if referencesDependency(r, candidate, dependency) {
+				requests = append(requests, ctrlreconcile.Request{
+					NamespacedName: client.ObjectKeyFromObject(&dependentList.Items[i]),
+				})
+			}
+		}
+
+		return requests
+	}
+}
+
+// referencesDependency determines if any dependency reference of a workload matches a dependency.
+func referencesDependency(r workload.Reconciler, candidate workload.Workload, dependency client.Object) bool {
+	for _, reference := range workload.GetDependencyReferences(candidate) {
+		matches, err := reference.Matches(dependency)
+		if err != nil {
+			r.GetLogger().Error(err, "unable to match dependency for workload", "workload", candidate.GetName())
+
+			continue
+		}
+
+		if matches {
+			return true
+		}
+	}
+
+	return false
+}
@@ -0,0 +1,176 @@
+/*
+	SPDX-License-Identifier: MIT
+*/
+
+package reconcile
+
+import (
+	"context"
+	"reflect"
+	"testing"
+
+	"github.com/go-logr/logr"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	"github.com/nukleros/operator-builder-tools/pkg/controller/workload"
+	"github.com/nukleros/operator-builder-tools/pkg/status"
+)
+
+var (
+	databaseGVK  = schema.GroupVersionKind{Group: "data.example.io", Version: "v1", Kind: "Database"}
+	dependentGVK = schema.GroupVersionKind{Group: "apps.example.io", Version: "v1", Kind: "App"}
+)
+
+// dependentWorkload is a workload which depends on the database named in its spec.
+type dependentWorkload struct {
+	metav1.TypeMeta   `json:",inline"`
+	metav1.ObjectMeta `json:"metadata,omitempty"`
+
+	Spec   dependentSpec   `json:"spec,omitempty"`
+	Status dependentStatus `json:"status,omitempty"`
+}
+
+type dependentSpec struct {
+	Database string `json:"database,omitempty"`
+}
+
+type dependentStatus struct {
+	DependenciesSatisfied bool `json:"dependenciesSatisfied,omitempty"`
+}
+
+func (w *dependentWorkload) DeepCopyObject() runtime.Object {
+	copied := *w
+	w.ObjectMeta.DeepCopyInto(&copied.ObjectMeta)
+
+	return &copied
+}
+
+func (w *dependentWorkload) GetDependencyReferences() []*workload.DependencyReference {
+	return []*workload.DependencyReference{{GroupVersionKind: databaseGVK, Name: w.Spec.Database}}
+}
+
+func (w *dependentWorkload) GetWorkloadGVK() schema.GroupVersionKind             { return dependentGVK }
+func (w *dependentWorkload) GetDependencies() []workload.Workload                { return nil }
+func (w *dependentWorkload) GetDependencyStatus() bool                           { return w.Status.DependenciesSatisfied }
+func (w *dependentWorkload) GetReadyStatus() bool                                { return false }
+func (w *dependentWorkload) GetPhaseConditions() []*status.PhaseCondition        { return nil }
+func (w *dependentWorkload) GetChildResourceConditions() []*status.ChildResource { return nil }
+func (w *dependentWorkload) SetReadyStatus(bool)                                 {}
+func (w *dependentWorkload) SetDependencyStatus(bool)                            {}
+func (w *dependentWorkload) SetPhaseCondition(*status.PhaseCondition)            {}
+func (w *dependentWorkload) SetChildResourceCondition(*status.ChildResource)     {}
+
+// dependentsReconciler is a reconciler which lists a fixed set of dependent workloads.
+type dependentsReconciler struct {
+	workload.Reconciler
+
+	scheme     *runtime.Scheme
+	dependents []unstructured.Unstructured
+}
+
+func newDependentsReconciler(t *testing.T, dependents ...*dependentWorkload) *dependentsReconciler {
+	t.Helper()
+
+	r := &dependentsReconciler{scheme: runtime.NewScheme()}
+	r.scheme.AddKnownTypeWithName(dependentGVK, &dependentWorkload{})
+
+	for _, dependent := range dependents {
+		dependent.SetGroupVersionKind(dependentGVK)
+
+		object, err := runtime.DefaultUnstructuredConverter.ToUnstructured(dependent)
+		if err != nil {
+			t.Fatalf("unable to convert dependent %s, %v", dependent.GetName(), err)
+		}
+
+		r.dependents = append(r.dependents, unstructured.Unstructured{Object: object})
+	}
+
+	return r
+}
+
+func (r *dependentsReconciler) GetLogger() logr.Logger  { return logr.Discard() }
+func (r *dependentsReconciler) Scheme() *runtime.Scheme { return r.scheme }
+
+func (r *dependentsReconciler) List(_ context.Context, list client.ObjectList, _ ...client.ListOption) error {
+	if list.GetObjectKind().GroupVersionKind() == dependentGVK {
+		list.(*unstructured.UnstructuredList).Items = r.dependents
+	}
+
+	return nil
+}
+
+func newDependent(name, database string, satisfied bool) *dependentWorkload {
+	return &dependentWorkload{
+		ObjectMeta: metav1.ObjectMeta{Name: name, Namespace: "default"},
+		Spec:       dependentSpec{Database: database},
+		Status:     dependentStatus{DependenciesSatisfied: satisfied},
+	}
+}
+
+func Test_dependents(t *testing.T) {
+	t.Parallel()
+
+	database := &unstructured.Unstructured{}
+	database.SetGroupVersionKind(databaseGVK)
+	database.SetName("primary")
+	database.SetNamespace("default")
+
+	otherKind := database.DeepCopy()
+	otherKind.SetKind("Cache")
+
+	tests := []struct {
+		name       string
+		dependents []*dependentWorkload
+		dependency client.Object
+		want       []string
+	}{
+		{
+			name:       "waiting dependents of the dependency are requeued",
+			dependents: []*dependentWorkload{newDependent("first", "primary", false), newDependent("second", "primary", false)},
+			dependency: database,
+			want:       []string{"first", "second"},
+		},
+		{
+			name:       "dependents with satisfied dependencies are not requeued",
+			dependents: []*dependentWorkload{newDependent("first", "primary", true), newDependent("second", "primary", false)},
+			dependency: database,
+			want:       []string{"second"},
+		},
+		{
+			name:       "dependents of another dependency are not requeued",
+			dependents: []*dependentWorkload{newDependent("first", "replica", false)},
+			dependency: database,
+			want:       []string{},
+		},
+		{
+			name:       "dependents are not requeued for another kind",
+			dependents: []*dependentWorkload{newDependent("first", "primary", false)},
+			dependency: otherKind,
+			want:       []string{},
+		},
+	}
+
+	for _, tt := range tests {
+		tt := tt
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			r := newDependentsReconciler(t, tt.dependents...)
+
+			requests := dependents(r, newDependent("dependent", "", false))(context.Background(), tt.dependency)
+
+			got := []string{}
+			for _, request := range requests {
+				got = append(got, request.Name)
+			}
+
+			if !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("dependents() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
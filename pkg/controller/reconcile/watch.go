@@ -5,15 +5,36 @@ package reconcile
 import (
 	"fmt"
 	"reflect"
+	"sync"
 
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/runtime/schema"
 	"sigs.k8s.io/controller-runtime/pkg/client"
 	"sigs.k8s.io/controller-runtime/pkg/handler"
+	"sigs.k8s.io/controller-runtime/pkg/predicate"
 	"sigs.k8s.io/controller-runtime/pkg/source"
 
 	"github.com/nukleros/operator-builder-tools/pkg/controller/predicates"
 	"github.com/nukleros/operator-builder-tools/pkg/controller/workload"
 )
 
+// watchLock serializes the registration of watches, as resources may be persisted concurrently
+// and reconcilers do not guard their list of watches.
+//
+//nolint:gochecknoglobals
+var watchLock sync.Mutex
+
+// watchTypeAnnotation is the annotation set on the object stubs stored in the watches of a reconciler
+// to distinguish watches of the same kind which enqueue requests for a different purpose.
+const watchTypeAnnotation = "operator-builder.nukleros.io/watch-type"
+
+const (
+	watchTypeChild      = ""
+	watchTypeCollection = "collection"
+	watchTypeDependency = "dependency"
+)
+
 // Watch watches a resource.
 func Watch(
 	r workload.Reconciler,
@@ -27,24 +48,11 @@ func Watch(
 		}
 	}
 
-	// check if the resource is already being watched
-	var watched bool
-
-	if len(r.GetWatches()) > 0 {
-		for _, watcher := range r.GetWatches() {
-			if reflect.DeepEqual(
-				resource.GetObjectKind().GroupVersionKind(),
-				watcher.GetObjectKind().GroupVersionKind(),
-			) {
-				watched = true
-
-				break
-			}
-		}
-	}
+	watchLock.Lock()
+	defer watchLock.Unlock()
 
 	// watch the resource if it current is not being watched
-	if !watched {
+	if !isWatched(r, resource, watchTypeChild) {
 		eventHandler := handler.EnqueueRequestForOwner(
 			r.GetManager().GetScheme(),
 			r.GetManager().GetRESTMapper(),
@@ -68,3 +76,84 @@ func Watch(
 
 	return nil
 }
+
+// isWatched determines if a resource of the same kind is already being watched for a particular
+// type of watch.
+func isWatched(r workload.Reconciler, resource client.Object, watchType string) bool {
+	for _, watcher := range r.GetWatches() {
+		if reflect.DeepEqual(
+			resource.GetObjectKind().GroupVersionKind(),
+			watcher.GetObjectKind().GroupVersionKind(),
+		) && watcher.GetAnnotations()[watchTypeAnnotation] == watchType {
+			return true
+		}
+	}
+
+	return false
+}
+
+// watchStub returns an object stub of a kind which is stored in the watches of a reconciler.
+func watchStub(gvk schema.GroupVersionKind, watchType string) *unstructured.Unstructured {
+	stub := &unstructured.Unstructured{}
+	stub.SetGroupVersionKind(gvk)
+	stub.SetAnnotations(map[string]string{watchTypeAnnotation: watchType})
+
+	return stub
+}
+
+// watchKind watches all objects of a kind with an event handler, unless the kind is already being
+// watched for the same type of watch.
+func watchKind(
+	r workload.Reconciler,
+	gvk schema.GroupVersionKind,
+	watchType string,
+	eventHandler handler.EventHandler,
+	predicate predicate.Predicate,
+) error {
+	watchLock.Lock()
+	defer watchLock.Unlock()
+
+	stub := watchStub(gvk, watchType)
+
+	if isWatched(r, stub, watchType) {
+		return nil
+	}
+
+	object := &unstructured.Unstructured{}
+	object.SetGroupVersionKind(gvk)
+
+	syncingSource := source.Kind(
+		r.GetManager().GetCache(),
+		client.Object(object),
+		eventHandler,
+		predicate,
+	)
+
+	if err := r.GetController().Watch(syncingSource); err != nil {
+		return fmt.Errorf("unable to watch %s, %w", gvk.Kind, err)
+	}
+
+	r.SetWatch(stub)
+
+	return nil
+}
+
+// toWorkload converts an object to the typed workload of its kind as registered with the scheme
+// of the reconciler.
+func toWorkload(r workload.Reconciler, object *unstructured.Unstructured) (workload.Workload, error) {
+	typed, err := r.Scheme().New(object.GroupVersionKind())
+	if err != nil {
+		return nil, fmt.Errorf("unable to create workload of kind %s, %w", object.GetKind(), err)
+	}
+
+	if err := runtime.DefaultUnstructuredConverter.FromUnstructured(object.Object, typed); err != nil {
+		return nil, fmt.Errorf("unable to convert workload %s, %w", object.GetName(), err)
+	}
+
+	converted, ok := typed.(workload.Workload)
+	if !ok {
+		return nil, fmt.Errorf("%w; kind %s does not implement a workload", workload.ErrInvalidWorkload, object.GetKind())
+	}
+
+	return converted, nil
+}
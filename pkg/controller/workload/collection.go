@@ -0,0 +1,66 @@
+// SPDX-License-Identifier: MIT
+
+package workload
+
+import (
+	"fmt"
+
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/labels"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+)
+
+// CollectionReference describes how a component workload locates the collection workload which
+// it belongs to.  When a name is provided, the collection is looked up directly, otherwise the
+// collection is found by namespace and label selector and exactly one collection must match.
+type CollectionReference struct {
+	// GroupVersionKind defines the kind of the collection workload.
+	GroupVersionKind schema.GroupVersionKind
+
+	// Name defines the optional name of the collection workload.
+	Name string
+
+	// Namespace defines the optional namespace of the collection workload.
+	Namespace string
+
+	// Selector defines the optional label selector used to find the collection workload.
+	Selector *metav1.LabelSelector
+}
+
+// CollectionMember represents a component Workload which belongs to a collection.  It is optional
+// to implement and allows the collection of a component to be resolved automatically.
+type CollectionMember interface {
+	GetCollectionReference() *CollectionReference
+}
+
+// Matches determines if an object is the collection referenced by a collection reference.
+func (reference *CollectionReference) Matches(collection client.Object) (bool, error) {
+	if collection == nil {
+		return false, nil
+	}
+
+	gvk := collection.GetObjectKind().GroupVersionKind()
+	if gvk.Group != reference.GroupVersionKind.Group || gvk.Kind != reference.GroupVersionKind.Kind {
+		return false, nil
+	}
+
+	if reference.Name != "" && reference.Name != collection.GetName() {
+		return false, nil
+	}
+
+	if reference.Namespace != "" && reference.Namespace != collection.GetNamespace() {
+		return false, nil
+	}
+
+	if reference.Selector == nil {
+		return true, nil
+	}
+
+	selector, err := metav1.LabelSelectorAsSelector(reference.Selector)
+	if err != nil {
+		return false, fmt.Errorf("invalid collection selector, %w", err)
+	}
+
+	return selector.Matches(labels.Set(collection.GetLabels())), nil
+}
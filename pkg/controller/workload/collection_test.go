@@ -0,0 +1,110 @@
+/*
+	SPDX-License-Identifier: MIT
+*/
+
+package workload_test
+
+import (
+	"testing"
+
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	"github.com/nukleros/operator-builder-tools/pkg/controller/workload"
+)
+
+func TestCollectionReference_Matches(t *testing.T) {
+	t.Parallel()
+
+	platformGVK := schema.GroupVersionKind{Group: "platform.example.io", Version: "v1alpha1", Kind: "Platform"}
+	platform := newWorkloadObject(platformGVK, "", "platform", map[string]string{"environment": "production"})
+
+	tests := []struct {
+		name      string
+		reference *workload.CollectionReference
+		object    client.Object
+		want      bool
+		wantErr   bool
+	}{
+		{
+			name:      "kind matches",
+			reference: &workload.CollectionReference{GroupVersionKind: platformGVK},
+			object:    platform,
+			want:      true,
+		},
+		{
+			name: "different kind does not match",
+			reference: &workload.CollectionReference{
+				GroupVersionKind: schema.GroupVersionKind{Group: "platform.example.io", Version: "v1alpha1", Kind: "Cluster"},
+			},
+			object: platform,
+		},
+		{
+			name:      "name matches",
+			reference: &workload.CollectionReference{GroupVersionKind: platformGVK, Name: "platform"},
+			object:    platform,
+			want:      true,
+		},
+		{
+			name:      "different name does not match",
+			reference: &workload.CollectionReference{GroupVersionKind: platformGVK, Name: "other"},
+			object:    platform,
+		},
+		{
+			name:      "namespace of a namespaced collection does not match a cluster-scoped collection",
+			reference: &workload.CollectionReference{GroupVersionKind: platformGVK, Namespace: "default"},
+			object:    platform,
+		},
+		{
+			name: "selector matches",
+			reference: &workload.CollectionReference{
+				GroupVersionKind: platformGVK,
+				Selector:         &metav1.LabelSelector{MatchLabels: map[string]string{"environment": "production"}},
+			},
+			object: platform,
+			want:   true,
+		},
+		{
+			name: "selector does not match",
+			reference: &workload.CollectionReference{
+				GroupVersionKind: platformGVK,
+				Selector:         &metav1.LabelSelector{MatchLabels: map[string]string{"environment": "staging"}},
+			},
+			object: platform,
+		},
+		{
+			name: "invalid selector returns an error",
+			reference: &workload.CollectionReference{
+				GroupVersionKind: platformGVK,
+				Selector: &metav1.LabelSelector{
+					MatchExpressions: []metav1.LabelSelectorRequirement{{Key: "environment", Operator: "Unknown"}},
+				},
+			},
+			object:  platform,
+			wantErr: true,
+		},
+		{
+			name:      "nil object does not match",
+			reference: &workload.CollectionReference{GroupVersionKind: platformGVK},
+		},
+	}
+
+	for _, tt := range tests {
+		tt := tt
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			got, err := tt.reference.Matches(tt.object)
+			if (err != nil) != tt.wantErr {
+				t.Errorf("CollectionReference.Matches() error = %v, wantErr %v", err, tt.wantErr)
+
+				return
+			}
+
+			if got != tt.want {
+				t.Errorf("CollectionReference.Matches() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
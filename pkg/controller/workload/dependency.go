@@ -0,0 +1,148 @@
+// SPDX-License-Identifier: MIT
+
+package workload
+
+import (
+	"fmt"
+	"strings"
+
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/labels"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+)
+
+// DependencyQuantifier defines how many of the workloads matching a dependency reference must be
+// ready for the dependency to be satisfied.
+type DependencyQuantifier string
+
+const (
+	// DependencyQuantifierAll requires all matching workloads to be ready.  This is the default.
+	DependencyQuantifierAll DependencyQuantifier = "All"
+
+	// DependencyQuantifierAny requires at least one matching workload to be ready.
+	DependencyQuantifierAny DependencyQuantifier = "Any"
+
+	// DependencyQuantifierOne requires exactly one matching workload, which must be ready.
+	DependencyQuantifierOne DependencyQuantifier = "One"
+)
+
+// DependencyReference describes a dependency of a workload.  A dependency always matches by kind and
+// may be narrowed by name, namespace and label selector.  At least one workload must match the
+// reference for the dependency to be satisfied.
+type DependencyReference struct {
+	// GroupVersionKind defines the kind of the dependency workload.
+	GroupVersionKind schema.GroupVersionKind
+
+	// Name defines the optional name of the dependency workload.
+	Name string
+
+	// Namespace defines the optional namespace of the dependency workload.
+	Namespace string
+
+	// Selector defines the optional label selector used to find the dependency workloads.
+	Selector *metav1.LabelSelector
+
+	// Quantifier defines how many of the matching workloads must be ready.  Defaults to All.
+	Quantifier DependencyQuantifier
+
+	// ConditionType defines the type of a standard status condition, such as Ready, which must be
+	// True for a matching workload to be ready.  When empty, the status.created field is used.
+	ConditionType string
+}
+
+// DependencyReferencer represents a Workload which describes its dependencies with dependency
+// references.  It is optional to implement and takes precedence over GetDependencies.
+type DependencyReferencer interface {
+	GetDependencyReferences() []*DependencyReference
+}
+
+// GetDependencyReferences returns the dependency references of a workload.  When the workload does not
+// implement the DependencyReferencer interface, the references are derived from the kind, name and
+// namespace of the workloads returned from GetDependencies.  A dependency without a name is matched by
+// kind only and requires exactly one workload of that kind, which must be ready, as dependencies
+// returned from GetDependencies have always been.
+func GetDependencyReferences(workload Workload) []*DependencyReference {
+	if referencer, ok := workload.(DependencyReferencer); ok {
+		return referencer.GetDependencyReferences()
+	}
+
+	dependencies := workload.GetDependencies()
+	references := make([]*DependencyReference, len(dependencies))
+
+	for i, dependency := range dependencies {
+		references[i] = &DependencyReference{
+			GroupVersionKind: dependency.GetWorkloadGVK(),
+			Name:             dependency.GetName(),
+			Namespace:        dependency.GetNamespace(),
+		}
+
+		if dependency.GetName() == "" {
+			references[i].Quantifier = DependencyQuantifierOne
+		}
+	}
+
+	return references
+}
+
+// Matches determines if an object matches the kind, name, namespace and selector of a dependency reference.
+func (reference *DependencyReference) Matches(object client.Object) (bool, error) {
+	if object == nil {
+		return false, nil
+	}
+
+	gvk := object.GetObjectKind().GroupVersionKind()
+	if gvk.Group != reference.GroupVersionKind.Group || gvk.Kind != reference.GroupVersionKind.Kind {
+		return false, nil
+	}
+
+	if reference.Name != "" && reference.Name != object.GetName() {
+		return false, nil
+	}
+
+	if reference.Namespace != "" && reference.Namespace != object.GetNamespace() {
+		return false, nil
+	}
+
+	if reference.Selector == nil {
+		return true, nil
+	}
+
+	selector, err := metav1.LabelSelectorAsSelector(reference.Selector)
+	if err != nil {
+		return false, fmt.Errorf("invalid dependency selector, %w", err)
+	}
+
+	return selector.Matches(labels.Set(object.GetLabels())), nil
+}
+
+// RequiresAll returns whether all matching workloads must be ready for the dependency to be satisfied.
+// This is true for the All and One quantifiers.
+func (reference *DependencyReference) RequiresAll() bool {
+	return reference.Quantifier != DependencyQuantifierAny
+}
+
+// RequiresOne returns whether exactly one workload must match for the dependency to be satisfied.
+func (reference *DependencyReference) RequiresOne() bool {
+	return reference.Quantifier == DependencyQuantifierOne
+}
+
+// String returns a human-readable description of a dependency reference.
+func (reference *DependencyReference) String() string {
+	description := []string{reference.GroupVersionKind.Kind}
+
+	switch {
+	case reference.Name != "" && reference.Namespace != "":
+		description = append(description, reference.Namespace+"/"+reference.Name)
+	case reference.Name != "":
+		description = append(description, reference.Name)
+	case reference.Namespace != "":
+		description = append(description, "in namespace "+reference.Namespace)
+	}
+
+	if reference.Selector != nil {
+		description = append(description, "with selector "+metav1.FormatLabelSelector(reference.Selector))
+	}
+
+	return strings.Join(description, " ")
+}
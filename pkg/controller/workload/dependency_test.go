@@ -0,0 +1,171 @@
+/*
+	SPDX-License-Identifier: MIT
+*/
+
+package workload_test
+
+import (
+	"testing"
+
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	"github.com/nukleros/operator-builder-tools/pkg/controller/workload"
+)
+
+//nolint:gochecknoglobals
+var databaseGVK = schema.GroupVersionKind{Group: "apps.example.io", Version: "v1", Kind: "Database"}
+
+func newWorkloadObject(gvk schema.GroupVersionKind, namespace, name string, labels map[string]string) *unstructured.Unstructured {
+	object := &unstructured.Unstructured{}
+	object.SetGroupVersionKind(gvk)
+	object.SetNamespace(namespace)
+	object.SetName(name)
+	object.SetLabels(labels)
+
+	return object
+}
+
+func TestDependencyReference_Matches(t *testing.T) {
+	t.Parallel()
+
+	database := newWorkloadObject(databaseGVK, "data", "primary", map[string]string{"tier": "storage"})
+
+	tests := []struct {
+		name      string
+		reference *workload.DependencyReference
+		object    client.Object
+		want      bool
+		wantErr   bool
+	}{
+		{
+			name:      "kind matches",
+			reference: &workload.DependencyReference{GroupVersionKind: databaseGVK},
+			object:    database,
+			want:      true,
+		},
+		{
+			name: "version is not compared",
+			reference: &workload.DependencyReference{
+				GroupVersionKind: schema.GroupVersionKind{Group: "apps.example.io", Version: "v2", Kind: "Database"},
+			},
+			object: database,
+			want:   true,
+		},
+		{
+			name: "different group does not match",
+			reference: &workload.DependencyReference{
+				GroupVersionKind: schema.GroupVersionKind{Group: "other.example.io", Version: "v1", Kind: "Database"},
+			},
+			object: database,
+		},
+		{
+			name: "different kind does not match",
+			reference: &workload.DependencyReference{
+				GroupVersionKind: schema.GroupVersionKind{Group: "apps.example.io", Version: "v1", Kind: "Cache"},
+			},
+			object: database,
+		},
+		{
+			name:      "name and namespace match",
+			reference: &workload.DependencyReference{GroupVersionKind: databaseGVK, Name: "primary", Namespace: "data"},
+			object:    database,
+			want:      true,
+		},
+		{
+			name:      "different name does not match",
+			reference: &workload.DependencyReference{GroupVersionKind: databaseGVK, Name: "replica"},
+			object:    database,
+		},
+		{
+			name:      "different namespace does not match",
+			reference: &workload.DependencyReference{GroupVersionKind: databaseGVK, Namespace: "other"},
+			object:    database,
+		},
+		{
+			name: "selector matches",
+			reference: &workload.DependencyReference{
+				GroupVersionKind: databaseGVK,
+				Selector:         &metav1.LabelSelector{MatchLabels: map[string]string{"tier": "storage"}},
+			},
+			object: database,
+			want:   true,
+		},
+		{
+			name: "selector does not match",
+			reference: &workload.DependencyReference{
+				GroupVersionKind: databaseGVK,
+				Selector:         &metav1.LabelSelector{MatchLabels: map[string]string{"tier": "cache"}},
+			},
+			object: database,
+		},
+		{
+			name: "invalid selector returns an error",
+			reference: &workload.DependencyReference{
+				GroupVersionKind: databaseGVK,
+				Selector: &metav1.LabelSelector{
+					MatchExpressions: []metav1.LabelSelectorRequirement{{Key: "tier", Operator: "Unknown"}},
+				},
+			},
+			object:  database,
+			wantErr: true,
+		},
+		{
+			name:      "nil object does not match",
+			reference: &workload.DependencyReference{GroupVersionKind: databaseGVK},
+		},
+	}
+
+	for _, tt := range tests {
+		tt := tt
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			got, err := tt.reference.Matches(tt.object)
+			if (err != nil) != tt.wantErr {
+				t.Errorf("DependencyReference.Matches() error = %v, wantErr %v", err, tt.wantErr)
+
+				return
+			}
+
+			if got != tt.want {
+				t.Errorf("DependencyReference.Matches() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestDependencyReference_Quantifiers(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name         string
+		quantifier   workload.DependencyQuantifier
+		wantAll      bool
+		wantExactOne bool
+	}{
+		{name: "default requires all", wantAll: true},
+		{name: "all requires all", quantifier: workload.DependencyQuantifierAll, wantAll: true},
+		{name: "any requires one to be ready", quantifier: workload.DependencyQuantifierAny},
+		{name: "one requires exactly one", quantifier: workload.DependencyQuantifierOne, wantAll: true, wantExactOne: true},
+	}
+
+	for _, tt := range tests {
+		tt := tt
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			reference := &workload.DependencyReference{GroupVersionKind: databaseGVK, Quantifier: tt.quantifier}
+
+			if got := reference.RequiresAll(); got != tt.wantAll {
+				t.Errorf("DependencyReference.RequiresAll() = %v, want %v", got, tt.wantAll)
+			}
+
+			if got := reference.RequiresOne(); got != tt.wantExactOne {
+				t.Errorf("DependencyReference.RequiresOne() = %v, want %v", got, tt.wantExactOne)
+			}
+		})
+	}
+}
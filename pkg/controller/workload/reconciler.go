@@ -3,6 +3,8 @@
 package workload
 
 import (
+	"fmt"
+
 	"github.com/go-logr/logr"
 	"k8s.io/client-go/tools/events"
 	"sigs.k8s.io/controller-runtime/pkg/client"
@@ -29,3 +31,30 @@ type Reconciler interface {
 	CheckReady(*Request) (bool, error)
 	Mutate(*Request, client.Object) ([]client.Object, bool, error)
 }
+
+// GetDesiredResources returns the desired child resources of a workload.  Each resource returned from
+// GetResources is passed through the Mutate method of the reconciler, which may replace the resource
+// with zero or more resources, or skip the resource entirely.
+func GetDesiredResources(r Reconciler, req *Request) ([]client.Object, error) {
+	resources, err := r.GetResources(req)
+	if err != nil {
+		return nil, err
+	}
+
+	desired := []client.Object{}
+
+	for _, resource := range resources {
+		mutated, skip, err := r.Mutate(req, resource)
+		if err != nil {
+			return nil, fmt.Errorf("unable to mutate resource %s, %w", resource.GetName(), err)
+		}
+
+		if skip {
+			continue
+		}
+
+		desired = append(desired, mutated...)
+	}
+
+	return desired, nil
+}
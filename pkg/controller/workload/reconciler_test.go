@@ -0,0 +1,131 @@
+/*
+	SPDX-License-Identifier: MIT
+*/
+
+package workload_test
+
+import (
+	"errors"
+	"reflect"
+	"testing"
+
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	"github.com/nukleros/operator-builder-tools/pkg/controller/workload"
+)
+
+var errMutate = errors.New("mutate failed")
+
+// mutateReconciler is a reconciler which only implements the methods used to retrieve the desired
+// resources of a workload.  Each resource is mutated with the mutation for its name, and resources
+// without a mutation are returned unchanged.
+type mutateReconciler struct {
+	workload.Reconciler
+
+	resources []client.Object
+	mutations map[string]func(client.Object) ([]client.Object, bool, error)
+	mutated   []string
+}
+
+func (r *mutateReconciler) GetResources(*workload.Request) ([]client.Object, error) {
+	return r.resources, nil
+}
+
+func (r *mutateReconciler) Mutate(_ *workload.Request, object client.Object) ([]client.Object, bool, error) {
+	r.mutated = append(r.mutated, object.GetName())
+
+	if mutation, ok := r.mutations[object.GetName()]; ok {
+		return mutation(object)
+	}
+
+	return []client.Object{object}, false, nil
+}
+
+func TestGetDesiredResources(t *testing.T) {
+	t.Parallel()
+
+	configMapGVK := schema.GroupVersionKind{Version: "v1", Kind: "ConfigMap"}
+
+	first := newWorkloadObject(configMapGVK, "default", "first", nil)
+	second := newWorkloadObject(configMapGVK, "default", "second", nil)
+	replacement := newWorkloadObject(configMapGVK, "default", "replacement", nil)
+
+	tests := []struct {
+		name        string
+		mutations   map[string]func(client.Object) ([]client.Object, bool, error)
+		want        []string
+		wantMutated []string
+		wantErr     error
+	}{
+		{
+			name:        "resources are mutated once each",
+			want:        []string{"first", "second"},
+			wantMutated: []string{"first", "second"},
+		},
+		{
+			name: "skipped resources are dropped",
+			mutations: map[string]func(client.Object) ([]client.Object, bool, error){
+				"first": func(object client.Object) ([]client.Object, bool, error) {
+					return []client.Object{object}, true, nil
+				},
+			},
+			want:        []string{"second"},
+			wantMutated: []string{"first", "second"},
+		},
+		{
+			name: "mutated resources replace the original resource",
+			mutations: map[string]func(client.Object) ([]client.Object, bool, error){
+				"first": func(client.Object) ([]client.Object, bool, error) {
+					return []client.Object{replacement}, false, nil
+				},
+			},
+			want:        []string{"replacement", "second"},
+			wantMutated: []string{"first", "second"},
+		},
+		{
+			name: "mutate errors are returned",
+			mutations: map[string]func(client.Object) ([]client.Object, bool, error){
+				"first": func(client.Object) ([]client.Object, bool, error) {
+					return nil, false, errMutate
+				},
+			},
+			wantMutated: []string{"first"},
+			wantErr:     errMutate,
+		},
+	}
+
+	for _, tt := range tests {
+		tt := tt
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			r := &mutateReconciler{
+				resources: []client.Object{first.DeepCopy(), second.DeepCopy()},
+				mutations: tt.mutations,
+			}
+
+			desired, err := workload.GetDesiredResources(r, &workload.Request{})
+			if !errors.Is(err, tt.wantErr) {
+				t.Fatalf("GetDesiredResources() error = %v, wantErr %v", err, tt.wantErr)
+			}
+
+			if !reflect.DeepEqual(r.mutated, tt.wantMutated) {
+				t.Errorf("GetDesiredResources() mutated = %v, want %v", r.mutated, tt.wantMutated)
+			}
+
+			if tt.wantErr != nil {
+				return
+			}
+
+			got := []string{}
+			for _, resource := range desired {
+				got = append(got, resource.GetName())
+			}
+
+			if !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("GetDesiredResources() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
@@ -4,9 +4,12 @@ package workload
 
 import (
 	"context"
+	"time"
 
 	"github.com/go-logr/logr"
 	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	"github.com/nukleros/operator-builder-tools/pkg/controller/plan"
 )
 
 // Request holds the state of the current reconcile request.
@@ -18,4 +21,24 @@ type Request struct {
 	Collection Workload
 	Resources  []client.Object
 	Log        logr.Logger
+
+	// Plan, when set, executes the request in plan mode.  In plan mode, no changes are persisted
+	// to the cluster and the changes which would have been made are recorded to the plan instead.
+	Plan *plan.Plan
+
+	// RequeueAfter, when set by a phase, requests that the workload is reconciled again after the
+	// duration once all phases have completed, such as when a certificate is due for renewal.
+	RequeueAfter time.Duration
+}
+
+// RequestRequeueAfter requests that the workload is reconciled again after a duration.  When requested
+// more than once, the shortest duration is used.  Durations which are not positive are ignored.
+func (req *Request) RequestRequeueAfter(duration time.Duration) {
+	if duration <= 0 {
+		return
+	}
+
+	if req.RequeueAfter == 0 || duration < req.RequeueAfter {
+		req.RequeueAfter = duration
+	}
 }
@@ -32,6 +32,13 @@ type Workload interface {
 	SetChildResourceCondition(*status.ChildResource)
 }
 
+// ChildResourceConditionRemover represents a Workload which is able to remove a child resource
+// condition from its status.  It is required by the PruneResourcesPhase so that child resources
+// which have been pruned are also removed from the status of the workload.
+type ChildResourceConditionRemover interface {
+	RemoveChildResourceCondition(*status.ChildResource)
+}
+
 // Validate validates an individual workload to ensure that its GVK is for the
 // correct resource.
 func Validate(workload Workload) error {
@@ -1,8 +1,14 @@
 package resources
 
 import (
+	"context"
 	"fmt"
+	"net"
+	"net/url"
+	"sync"
+	"time"
 
+	cmv1 "github.com/cert-manager/cert-manager/pkg/apis/certmanager/v1"
 	"github.com/nukleros/operator-builder-tools/pkg/controller/workload"
 	admissionv1 "k8s.io/api/admissionregistration/v1"
 	v1 "k8s.io/api/core/v1"
@@ -18,6 +24,34 @@ const (
 	ValidatingWebhookConfigurationVersion = "admissionregistration.k8s.io/v1"
 )
 
+// ProbeWebhookURLsAnnotation may be set to "true" on a webhook configuration to ensure that a connection
+// can be opened to each webhook which is configured with a URL rather than a service.  Otherwise, webhooks
+// configured with a URL are not considered when determining readiness.  A URL which was reachable is not
+// probed again until the probe interval has passed.
+const ProbeWebhookURLsAnnotation = "operator-builder.nukleros.io/probe-webhook-urls"
+
+const (
+	// webhookProbeTimeout is the maximum time to wait for connections to the webhook URLs of a webhook
+	// configuration.  The URLs are probed concurrently, so this is the total time spent probing.
+	webhookProbeTimeout = 5 * time.Second
+
+	// webhookProbeInterval is the time for which a successful probe of a webhook URL is trusted before
+	// the URL is probed again.
+	webhookProbeInterval = 5 * time.Minute
+)
+
+// webhookProbes stores the time of the last successful probe of each webhook URL so that reachable URLs
+// are not probed on every reconciliation.
+//
+//nolint:gochecknoglobals
+var webhookProbes = &probeCache{probed: map[string]time.Time{}}
+
+// probeCache stores the time of the last successful probe keyed by webhook URL.
+type probeCache struct {
+	lock   sync.Mutex
+	probed map[string]time.Time
+}
+
 // MutatingWebhookConfigurationResource represents a Kubernetes
 // MutatingWebhookConfiguration object.
 type MutatingWebhookConfigurationResource struct {
@@ -76,78 +110,250 @@ func NewValidatingWebhookConfigurationResource(
 
 // IsReady performs the logic to determine if a MutatingWebhookConfiguration is ready.
 func (webhook *MutatingWebhookConfigurationResource) IsReady() (bool, error) {
-	return isReady(webhook, webhook.Reconciler, webhook.Request)
+	return readinessIsReady(webhook.Readiness())
 }
 
-// IsReady performs the logic to determine if a MutatingWebhookConfiguration is ready.
+// Readiness performs the logic to determine if a MutatingWebhookConfiguration is ready along with the
+// reason for it.
+func (webhook *MutatingWebhookConfigurationResource) Readiness() (*Readiness, error) {
+	return webhookReadiness(&webhook.Object, webhook.clientConfigs(), webhook.Reconciler, webhook.Request)
+}
+
+// IsReady performs the logic to determine if a ValidatingWebhookConfiguration is ready.
 func (webhook *ValidatingWebhookConfigurationResource) IsReady() (bool, error) {
-	return isReady(webhook, webhook.Reconciler, webhook.Request)
+	return readinessIsReady(webhook.Readiness())
+}
+
+// Readiness performs the logic to determine if a ValidatingWebhookConfiguration is ready along with the
+// reason for it.
+func (webhook *ValidatingWebhookConfigurationResource) Readiness() (*Readiness, error) {
+	return webhookReadiness(&webhook.Object, webhook.clientConfigs(), webhook.Reconciler, webhook.Request)
 }
 
 // GetServiceStubs gets the service stubs objects from a MutatingWebhookConfigurationResource.  The stubs
-// are used to lookup the underlying services associated with the webhook.
+// are used to lookup the underlying services associated with the webhook.  Webhooks which are configured
+// with a URL rather than a service are skipped, and services shared by several webhooks are only
+// returned once.
 func (webhook *MutatingWebhookConfigurationResource) GetServiceStubs() []v1.Service {
-	services := []v1.Service{}
+	return serviceStubsFor(webhook.clientConfigs())
+}
 
-	for _, mutating := range webhook.Object.Webhooks {
-		service := v1.Service{
-			ObjectMeta: metav1.ObjectMeta{
-				Name:      mutating.ClientConfig.Service.Name,
-				Namespace: mutating.ClientConfig.Service.Namespace,
-			},
-		}
+// GetServiceStubs gets the service stubs objects from a ValidatingWebhookConfigurationResource.  The stubs
+// are used to lookup the underlying services associated with the webhook.  Webhooks which are configured
+// with a URL rather than a service are skipped, and services shared by several webhooks are only
+// returned once.
+func (webhook *ValidatingWebhookConfigurationResource) GetServiceStubs() []v1.Service {
+	return serviceStubsFor(webhook.clientConfigs())
+}
 
-		services = append(services, service)
+// clientConfigs returns the client configuration of each webhook of a MutatingWebhookConfigurationResource.
+func (webhook *MutatingWebhookConfigurationResource) clientConfigs() []admissionv1.WebhookClientConfig {
+	configs := make([]admissionv1.WebhookClientConfig, len(webhook.Object.Webhooks))
+
+	for i := range webhook.Object.Webhooks {
+		configs[i] = webhook.Object.Webhooks[i].ClientConfig
 	}
 
-	return services
+	return configs
 }
 
-// GetServiceStubs gets the service stubs objects from a ValidatingWebhookConfigurationResource.  The stubs
-// are used to lookup the underlying services associated with the webhook.
-func (webhook *ValidatingWebhookConfigurationResource) GetServiceStubs() []v1.Service {
+// clientConfigs returns the client configuration of each webhook of a ValidatingWebhookConfigurationResource.
+func (webhook *ValidatingWebhookConfigurationResource) clientConfigs() []admissionv1.WebhookClientConfig {
+	configs := make([]admissionv1.WebhookClientConfig, len(webhook.Object.Webhooks))
+
+	for i := range webhook.Object.Webhooks {
+		configs[i] = webhook.Object.Webhooks[i].ClientConfig
+	}
+
+	return configs
+}
+
+// serviceStubsFor returns the unique service stubs of a set of webhook client configurations, skipping
+// client configurations which use a URL.
+func serviceStubsFor(configs []admissionv1.WebhookClientConfig) []v1.Service {
 	services := []v1.Service{}
+	seen := map[string]bool{}
 
-	for _, validating := range webhook.Object.Webhooks {
-		service := v1.Service{
+	for _, config := range configs {
+		if config.Service == nil {
+			continue
+		}
+
+		key := config.Service.Namespace + "/" + config.Service.Name
+		if seen[key] {
+			continue
+		}
+
+		seen[key] = true
+
+		services = append(services, v1.Service{
 			TypeMeta: metav1.TypeMeta{
 				Kind:       ServiceKind,
 				APIVersion: ServiceVersion,
 			},
 			ObjectMeta: metav1.ObjectMeta{
-				Name:      validating.ClientConfig.Service.Name,
-				Namespace: validating.ClientConfig.Service.Namespace,
+				Name:      config.Service.Name,
+				Namespace: config.Service.Namespace,
 			},
-		}
-
-		services = append(services, service)
+		})
 	}
 
 	return services
 }
 
-// isReady determines if a webhook resource is ready.
-func isReady(serviceRetriever ServiceStubRetriever, r workload.Reconciler, req *workload.Request) (bool, error) {
-	for _, service := range serviceRetriever.GetServiceStubs() {
+// webhookReadiness determines if a webhook configuration is ready.  A webhook configuration which relies on
+// cert-manager CA injection is not ready until each webhook has a caBundle.  Each service which backs a
+// webhook must have the minimum number of ready endpoints set by the MinReadyEndpointsAnnotation.  Webhooks
+// which are configured with a URL are only probed when requested with the ProbeWebhookURLsAnnotation.
+func webhookReadiness(
+	object metav1.Object,
+	configs []admissionv1.WebhookClientConfig,
+	r workload.Reconciler,
+	req *workload.Request,
+) (*Readiness, error) {
+	// if we have a name that is empty, we know we did not find the object
+	if object.GetName() == "" {
+		return Progressing("NotFound", "webhook configuration not found"), nil
+	}
+
+	if hasCAInjection(object) {
+		for _, config := range configs {
+			if len(config.CABundle) == 0 {
+				return Progressing("CABundleNotInjected", "caBundle has not been injected by cert-manager"), nil
+			}
+		}
+	}
+
+	minReady, err := minReadyEndpoints(object)
+	if err != nil {
+		return nil, err
+	}
+
+	for _, service := range serviceStubsFor(configs) {
 		service, err := NewServiceResource(&service)
 		if err != nil {
-			return false, err
+			return nil, err
 		}
 
-		endpoints, err := service.GetEndpoints(r, req)
+		readiness, err := serviceReadiness(r, req, service, minReady)
 		if err != nil {
-			return false, fmt.Errorf("unable to retrieve endpoints from service - %w", err)
+			return nil, fmt.Errorf("unable to determine endpoint readiness - %w", err)
 		}
 
-		ready, err := endpoints.IsReady()
-		if err != nil {
-			return false, fmt.Errorf("unable to determine endpoint readiness = %w", err)
+		if !readiness.IsReady() {
+			return readiness, nil
+		}
+	}
+
+	if object.GetAnnotations()[ProbeWebhookURLsAnnotation] != "true" {
+		return Ready("webhook services are ready"), nil
+	}
+
+	urls := []string{}
+	for _, config := range configs {
+		if config.URL != nil {
+			urls = append(urls, *config.URL)
 		}
+	}
+
+	ctx := context.Background()
+	if req != nil && req.Context != nil {
+		ctx = req.Context
+	}
+
+	if err := webhookProbes.probe(ctx, urls...); err != nil {
+		return Progressing("URLNotReachable", err.Error()), nil
+	}
 
-		if !ready {
-			return false, nil
+	return Ready("webhook services and urls are ready"), nil
+}
+
+// hasCAInjection determines if an object relies on cert-manager to inject its caBundle.
+func hasCAInjection(object metav1.Object) bool {
+	annotations := object.GetAnnotations()
+
+	return annotations[cmv1.WantInjectAnnotation] != "" ||
+		annotations[cmv1.WantInjectFromSecretAnnotation] != "" ||
+		annotations[cmv1.WantInjectAPIServerCAAnnotation] == "true"
+}
+
+// probe ensures that a connection can be opened to each webhook URL which has not been probed successfully
+// within the probe interval.  The URLs are probed concurrently and the first error, in the order of the
+// URLs, is returned.
+func (cache *probeCache) probe(ctx context.Context, urls ...string) error {
+	now := time.Now()
+	pending := []string{}
+
+	queued := map[string]bool{}
+
+	cache.lock.Lock()
+	for _, webhookURL := range urls {
+		if probedAt, ok := cache.probed[webhookURL]; (!ok || now.Sub(probedAt) > webhookProbeInterval) && !queued[webhookURL] {
+			pending = append(pending, webhookURL)
+			queued[webhookURL] = true
 		}
 	}
+	cache.lock.Unlock()
+
+	if len(pending) == 0 {
+		return nil
+	}
+
+	ctx, cancel := context.WithTimeout(ctx, webhookProbeTimeout)
+	defer cancel()
+
+	errs := make([]error, len(pending))
+
+	var wg sync.WaitGroup
+
+	for i := range pending {
+		wg.Add(1)
+
+		go func(i int) {
+			defer wg.Done()
+
+			errs[i] = probeWebhookURL(ctx, pending[i])
+		}(i)
+	}
+
+	wg.Wait()
+
+	cache.lock.Lock()
+	defer cache.lock.Unlock()
+
+	for i := range pending {
+		if errs[i] == nil {
+			cache.probed[pending[i]] = now
+		}
+	}
+
+	for i := range errs {
+		if errs[i] != nil {
+			return errs[i]
+		}
+	}
+
+	return nil
+}
+
+// probeWebhookURL ensures that a connection can be opened to the host of a webhook URL.
+func probeWebhookURL(ctx context.Context, rawURL string) error {
+	webhookURL, err := url.Parse(rawURL)
+	if err != nil {
+		return fmt.Errorf("unable to parse webhook url [%s] - %w", rawURL, err)
+	}
+
+	port := webhookURL.Port()
+	if port == "" {
+		port = "443"
+	}
+
+	dialer := &net.Dialer{}
+
+	connection, err := dialer.DialContext(ctx, "tcp", net.JoinHostPort(webhookURL.Hostname(), port))
+	if err != nil {
+		return fmt.Errorf("unable to connect to webhook url [%s] - %w", rawURL, err)
+	}
 
-	return true, nil
+	return connection.Close()
 }
@@ -0,0 +1,245 @@
+/*
+	SPDX-License-Identifier: MIT
+*/
+
+package resources_test
+
+import (
+	"context"
+	"net"
+	"reflect"
+	"testing"
+
+	admissionv1 "k8s.io/api/admissionregistration/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	"github.com/nukleros/operator-builder-tools/pkg/controller/workload"
+	"github.com/nukleros/operator-builder-tools/pkg/resources"
+)
+
+func serviceClientConfig(name string, caBundle []byte) admissionv1.WebhookClientConfig {
+	return admissionv1.WebhookClientConfig{
+		Service:  &admissionv1.ServiceReference{Name: name, Namespace: "system"},
+		CABundle: caBundle,
+	}
+}
+
+func urlClientConfig(url string) admissionv1.WebhookClientConfig {
+	return admissionv1.WebhookClientConfig{URL: &url}
+}
+
+func TestValidatingWebhookConfigurationResource_GetServiceStubs(t *testing.T) {
+	t.Parallel()
+
+	webhook := &resources.ValidatingWebhookConfigurationResource{
+		Object: admissionv1.ValidatingWebhookConfiguration{
+			Webhooks: []admissionv1.ValidatingWebhook{
+				{Name: "first.example.io", ClientConfig: serviceClientConfig("webhook", nil)},
+				{Name: "url.example.io", ClientConfig: urlClientConfig("https://webhook.example.io/validate")},
+				{Name: "second.example.io", ClientConfig: serviceClientConfig("webhook", nil)},
+				{Name: "other.example.io", ClientConfig: serviceClientConfig("other", nil)},
+			},
+		},
+	}
+
+	got := []string{}
+	for _, service := range webhook.GetServiceStubs() {
+		got = append(got, service.Namespace+"/"+service.Name)
+	}
+
+	want := []string{"system/webhook", "system/other"}
+	if !reflect.DeepEqual(got, want) {
+		t.Errorf("ValidatingWebhookConfigurationResource.GetServiceStubs() = %v, want %v", got, want)
+	}
+}
+
+func TestMutatingWebhookConfigurationResource_IsReady(t *testing.T) {
+	t.Parallel()
+
+	listener, err := net.Listen("tcp", "127.0.0.1:0")
+	if err != nil {
+		t.Fatalf("unable to listen, %v", err)
+	}
+
+	t.Cleanup(func() { listener.Close() })
+
+	closed, err := net.Listen("tcp", "127.0.0.1:0")
+	if err != nil {
+		t.Fatalf("unable to listen, %v", err)
+	}
+
+	closed.Close()
+
+	readyEndpoints := newEndpointSlice("system", "webhook", true)
+
+	injected := map[string]string{"cert-manager.io/inject-ca-from": "system/webhook-cert"}
+	probed := map[string]string{resources.ProbeWebhookURLsAnnotation: "true"}
+
+	type fields struct {
+		annotations map[string]string
+		configs     []admissionv1.WebhookClientConfig
+	}
+
+	tests := []struct {
+		name    string
+		fields  fields
+		want    bool
+		wantErr bool
+	}{
+		{
+			name:    "webhook should be ready (service endpoints ready)",
+			want:    true,
+			wantErr: false,
+			fields: fields{
+				configs: []admissionv1.WebhookClientConfig{serviceClientConfig("webhook", nil)},
+			},
+		},
+		{
+			name:    "webhook should be ready (url is skipped)",
+			want:    true,
+			wantErr: false,
+			fields: fields{
+				configs: []admissionv1.WebhookClientConfig{
+					serviceClientConfig("webhook", nil),
+					urlClientConfig("https://" + closed.Addr().String() + "/mutate"),
+				},
+			},
+		},
+		{
+			name:    "webhook should be ready (url is probed)",
+			want:    true,
+			wantErr: false,
+			fields: fields{
+				annotations: probed,
+				configs:     []admissionv1.WebhookClientConfig{urlClientConfig("https://" + listener.Addr().String() + "/mutate")},
+			},
+		},
+		{
+			name:    "webhook should not be ready (url is not reachable)",
+			want:    false,
+			wantErr: false,
+			fields: fields{
+				annotations: probed,
+				configs:     []admissionv1.WebhookClientConfig{urlClientConfig("https://" + closed.Addr().String() + "/mutate")},
+			},
+		},
+		{
+			name:    "webhook should be ready (ca bundle injected)",
+			want:    true,
+			wantErr: false,
+			fields: fields{
+				annotations: injected,
+				configs:     []admissionv1.WebhookClientConfig{serviceClientConfig("webhook", []byte("ca"))},
+			},
+		},
+		{
+			name:    "webhook should not be ready (ca bundle not injected)",
+			want:    false,
+			wantErr: false,
+			fields: fields{
+				annotations: injected,
+				configs:     []admissionv1.WebhookClientConfig{serviceClientConfig("webhook", nil)},
+			},
+		},
+		{
+			name:    "webhook should not be ready (minimum ready endpoints)",
+			want:    false,
+			wantErr: false,
+			fields: fields{
+				annotations: map[string]string{resources.MinReadyEndpointsAnnotation: "2"},
+				configs:     []admissionv1.WebhookClientConfig{serviceClientConfig("webhook", nil)},
+			},
+		},
+		{
+			name:    "webhook should not be ready (service endpoints missing)",
+			want:    false,
+			wantErr: false,
+			fields: fields{
+				configs: []admissionv1.WebhookClientConfig{serviceClientConfig("missing", nil)},
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		tt := tt
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			configuration := admissionv1.MutatingWebhookConfiguration{
+				ObjectMeta: metav1.ObjectMeta{Name: "webhook", Annotations: tt.fields.annotations},
+			}
+
+			for _, config := range tt.fields.configs {
+				configuration.Webhooks = append(configuration.Webhooks, admissionv1.MutatingWebhook{ClientConfig: config})
+			}
+
+			webhook := &resources.MutatingWebhookConfigurationResource{
+				Object:     configuration,
+				Reconciler: newClientReconciler(readyEndpoints),
+				Request:    &workload.Request{Context: context.Background()},
+			}
+
+			got, err := webhook.IsReady()
+			if (err != nil) != tt.wantErr {
+				t.Errorf("MutatingWebhookConfigurationResource.IsReady() error = %v, wantErr %v", err, tt.wantErr)
+
+				return
+			}
+
+			if got != tt.want {
+				t.Errorf("MutatingWebhookConfigurationResource.IsReady() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestMutatingWebhookConfigurationResource_IsReady_ProbeCache(t *testing.T) {
+	t.Parallel()
+
+	listener, err := net.Listen("tcp", "127.0.0.1:0")
+	if err != nil {
+		t.Fatalf("unable to listen, %v", err)
+	}
+
+	webhookURL := "https://" + listener.Addr().String() + "/mutate"
+
+	isReady := func(ctx context.Context) bool {
+		webhook := &resources.MutatingWebhookConfigurationResource{
+			Object: admissionv1.MutatingWebhookConfiguration{
+				ObjectMeta: metav1.ObjectMeta{
+					Name:        "webhook",
+					Annotations: map[string]string{resources.ProbeWebhookURLsAnnotation: "true"},
+				},
+				Webhooks: []admissionv1.MutatingWebhook{{ClientConfig: urlClientConfig(webhookURL)}},
+			},
+			Reconciler: newClientReconciler(),
+			Request:    &workload.Request{Context: ctx},
+		}
+
+		ready, err := webhook.IsReady()
+		if err != nil {
+			t.Fatalf("MutatingWebhookConfigurationResource.IsReady() error = %v", err)
+		}
+
+		return ready
+	}
+
+	// the probe uses the context of the request
+	cancelled, cancel := context.WithCancel(context.Background())
+	cancel()
+
+	if isReady(cancelled) {
+		t.Errorf("MutatingWebhookConfigurationResource.IsReady() with a cancelled request = true, want false")
+	}
+
+	if !isReady(context.Background()) {
+		t.Errorf("MutatingWebhookConfigurationResource.IsReady() = false, want true")
+	}
+
+	// a successful probe is not repeated on the next readiness check
+	listener.Close()
+
+	if !isReady(context.Background()) {
+		t.Errorf("MutatingWebhookConfigurationResource.IsReady() after a successful probe = false, want true")
+	}
+}
@@ -0,0 +1,70 @@
+/*
+	SPDX-License-Identifier: MIT
+*/
+
+package resources
+
+import (
+	"fmt"
+
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+)
+
+const (
+	APIServiceKind    = "APIService"
+	APIServiceVersion = "apiregistration.k8s.io/v1"
+)
+
+// apiServiceAvailableCondition is the condition which is true once the aggregated API server behind an
+// APIService is able to serve requests.
+const apiServiceAvailableCondition = "Available"
+
+// APIServiceResource represents a Kubernetes APIService object.  The object is stored unstructured
+// so that the aggregator types are not required.
+type APIServiceResource struct {
+	Object unstructured.Unstructured
+}
+
+// NewAPIServiceResource creates and returns a new APIServiceResource.
+func NewAPIServiceResource(object client.Object) (*APIServiceResource, error) {
+	apiService, err := ToUnstructured(object)
+	if err != nil {
+		return nil, err
+	}
+
+	return &APIServiceResource{Object: *apiService}, nil
+}
+
+// IsReady checks to see if an APIService is ready.
+func (apiService *APIServiceResource) IsReady() (bool, error) {
+	return readinessIsReady(apiService.Readiness())
+}
+
+// Readiness checks to see if an APIService is ready along with the reason for it.  An APIService is
+// ready once its Available condition is true.
+func (apiService *APIServiceResource) Readiness() (*Readiness, error) {
+	// if we have a name that is empty, we know we did not find the object
+	if apiService.Object.GetName() == "" {
+		return Progressing("NotFound", "apiservice not found"), nil
+	}
+
+	conditions, _, err := unstructured.NestedSlice(apiService.Object.Object, "status", "conditions")
+	if err != nil {
+		return nil, fmt.Errorf("unable to retrieve status.conditions field, %w", err)
+	}
+
+	for _, condition := range conditions {
+		fields, ok := condition.(map[string]interface{})
+		if !ok || fields["type"] != apiServiceAvailableCondition {
+			continue
+		}
+
+		reason, _ := fields["reason"].(string)
+		message, _ := fields["message"].(string)
+
+		return conditionReadiness(fields["status"] == "True", reason, message), nil
+	}
+
+	return Progressing("AvailableUnknown", "apiservice has not reported whether it is available"), nil
+}
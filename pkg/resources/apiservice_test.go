@@ -0,0 +1,112 @@
+/*
+	SPDX-License-Identifier: MIT
+*/
+
+package resources_test
+
+import (
+	"testing"
+
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+
+	"github.com/nukleros/operator-builder-tools/pkg/resources"
+)
+
+func newAPIService(name string, conditions ...interface{}) *unstructured.Unstructured {
+	apiService := &unstructured.Unstructured{
+		Object: map[string]interface{}{
+			"apiVersion": resources.APIServiceVersion,
+			"kind":       resources.APIServiceKind,
+			"metadata":   map[string]interface{}{},
+		},
+	}
+
+	if name != "" {
+		apiService.SetName(name)
+	}
+
+	if len(conditions) > 0 {
+		apiService.Object["status"] = map[string]interface{}{"conditions": conditions}
+	}
+
+	return apiService
+}
+
+func TestAPIServiceResource_IsReady(t *testing.T) {
+	t.Parallel()
+
+	type fields struct {
+		parent *unstructured.Unstructured
+	}
+
+	tests := []struct {
+		name    string
+		fields  fields
+		want    bool
+		wantErr bool
+	}{
+		{
+			name:    "apiservice should be ready",
+			want:    true,
+			wantErr: false,
+			fields: fields{
+				parent: newAPIService("v1.ready.example.io", map[string]interface{}{
+					"type":   "Available",
+					"status": "True",
+				}),
+			},
+		},
+		{
+			name:    "apiservice should not be ready (not available)",
+			want:    false,
+			wantErr: false,
+			fields: fields{
+				parent: newAPIService("v1.not-ready.example.io", map[string]interface{}{
+					"type":    "Available",
+					"status":  "False",
+					"reason":  "MissingEndpoints",
+					"message": "endpoints for service/api in \"system\" have no addresses",
+				}),
+			},
+		},
+		{
+			name:    "apiservice should not be ready (no conditions)",
+			want:    false,
+			wantErr: false,
+			fields: fields{
+				parent: newAPIService("v1.no-conditions.example.io"),
+			},
+		},
+		{
+			name:    "apiservice should not be ready (empty)",
+			want:    false,
+			wantErr: false,
+			fields: fields{
+				parent: newAPIService(""),
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		tt := tt
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			apiService, err := resources.NewAPIServiceResource(tt.fields.parent)
+			if err != nil {
+				t.Fatalf("NewAPIServiceResource() error = %v", err)
+			}
+
+			got, err := apiService.IsReady()
+			if (err != nil) != tt.wantErr {
+				t.Errorf("APIServiceResource.IsReady() error = %v, wantErr %v", err, tt.wantErr)
+
+				return
+			}
+
+			if got != tt.want {
+				t.Errorf("APIServiceResource.IsReady() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
@@ -1,17 +1,39 @@
+/*
+	SPDX-License-Identifier: MIT
+*/
+
 package resources
 
 import (
+	"fmt"
+	"strings"
+	"time"
+
+	cmacmev1 "github.com/cert-manager/cert-manager/pkg/apis/acme/v1"
 	cmv1 "github.com/cert-manager/cert-manager/pkg/apis/certmanager/v1"
 	cmmetav1 "github.com/cert-manager/cert-manager/pkg/apis/meta/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"sigs.k8s.io/controller-runtime/pkg/client"
 )
 
 const (
-	IssuerKind        = cmv1.IssuerKind
-	ClusterIssuerKind = cmv1.ClusterIssuerKind
-	CertificateKind   = cmv1.CertificateKind
+	IssuerKind             = cmv1.IssuerKind
+	ClusterIssuerKind      = cmv1.ClusterIssuerKind
+	CertificateKind        = cmv1.CertificateKind
+	CertificateRequestKind = cmv1.CertificateRequestKind
+	OrderKind              = cmacmev1.OrderKind
+	ChallengeKind          = cmacmev1.ChallengeKind
 )
 
+// reasons used for the readiness of certificates which are ready but are close to expiry.
+const (
+	CertificateReasonRenewalDue = "RenewalDue"
+)
+
+// certificateRenewalRequeue is the interval at which the readiness of a certificate which is due for renewal
+// is checked again, so that a certificate which is stuck renewing is noticed before it expires.
+const certificateRenewalRequeue = 5 * time.Minute
+
 // IssuerResource represents a cert-manager Issuer object.
 type IssuerResource struct {
 	Object cmv1.Issuer
@@ -27,6 +49,21 @@ type CertificateResource struct {
 	Object cmv1.Certificate
 }
 
+// CertificateRequestResource represents a cert-manager CertificateRequest object.
+type CertificateRequestResource struct {
+	Object cmv1.CertificateRequest
+}
+
+// OrderResource represents a cert-manager ACME Order object.
+type OrderResource struct {
+	Object cmacmev1.Order
+}
+
+// ChallengeResource represents a cert-manager ACME Challenge object.
+type ChallengeResource struct {
+	Object cmacmev1.Challenge
+}
+
 // NewIssuerResource creates and returns a new IssuerResource.
 func NewIssuerResource(object client.Object) (*IssuerResource, error) {
 	issuer := &cmv1.Issuer{}
@@ -63,34 +100,270 @@ func NewCertificateResource(object client.Object) (*CertificateResource, error)
 	return &CertificateResource{Object: *cert}, nil
 }
 
+// NewCertificateRequestResource creates and returns a new CertificateRequestResource.
+func NewCertificateRequestResource(object client.Object) (*CertificateRequestResource, error) {
+	request := &cmv1.CertificateRequest{}
+
+	if err := ToTyped(request, object); err != nil {
+		return nil, err
+	}
+
+	return &CertificateRequestResource{Object: *request}, nil
+}
+
+// NewOrderResource creates and returns a new OrderResource.
+func NewOrderResource(object client.Object) (*OrderResource, error) {
+	order := &cmacmev1.Order{}
+
+	if err := ToTyped(order, object); err != nil {
+		return nil, err
+	}
+
+	return &OrderResource{Object: *order}, nil
+}
+
+// NewChallengeResource creates and returns a new ChallengeResource.
+func NewChallengeResource(object client.Object) (*ChallengeResource, error) {
+	challenge := &cmacmev1.Challenge{}
+
+	if err := ToTyped(challenge, object); err != nil {
+		return nil, err
+	}
+
+	return &ChallengeResource{Object: *challenge}, nil
+}
+
 // IsReady checks to see if an Issuer is ready.
 func (issuer *IssuerResource) IsReady() (bool, error) {
-	return issuerIsReady(issuer.Object.Status.Conditions)
+	return readinessIsReady(issuer.Readiness())
 }
 
-// IsReady checks to see if an Issuer is ready.
+// Readiness checks to see if an Issuer is ready along with the reason for it.
+func (issuer *IssuerResource) Readiness() (*Readiness, error) {
+	return issuerReadiness(issuer.Object.Status.Conditions)
+}
+
+// IsReady checks to see if a ClusterIssuer is ready.
 func (clusterIssuer *ClusterIssuerResource) IsReady() (bool, error) {
-	return issuerIsReady(clusterIssuer.Object.Status.Conditions)
+	return readinessIsReady(clusterIssuer.Readiness())
+}
+
+// Readiness checks to see if a ClusterIssuer is ready along with the reason for it.
+func (clusterIssuer *ClusterIssuerResource) Readiness() (*Readiness, error) {
+	return issuerReadiness(clusterIssuer.Object.Status.Conditions)
 }
 
-// IsReady checks to see if an Certificate is ready.
+// IsReady checks to see if a Certificate is ready.
 func (cert *CertificateResource) IsReady() (bool, error) {
+	return readinessIsReady(cert.Readiness())
+}
+
+// Readiness checks to see if a Certificate is ready along with the reason for it.  A certificate which is
+// ready but is due for renewal reports the RenewalDue reason, and a certificate whose last issuance failed
+// is diagnosed with the failure.  The readiness of a ready certificate should be checked again once it is
+// due for renewal, and periodically while its renewal is overdue.
+func (cert *CertificateResource) Readiness() (*Readiness, error) {
+	readiness := Progressing(ReadinessReasonNotReady, "certificate has no ready condition")
+
 	for _, condition := range cert.Object.Status.Conditions {
-		if condition.Type == cmv1.CertificateConditionReady && condition.Status == cmmetav1.ConditionTrue {
-			return true, nil
+		if condition.Type == cmv1.CertificateConditionReady {
+			readiness = conditionReadiness(condition.Status == cmmetav1.ConditionTrue, condition.Reason, condition.Message)
+
+			break
 		}
 	}
 
-	return false, nil
+	if readiness.IsReady() {
+		readiness = cert.expiryReadiness(readiness, time.Now())
+	}
+
+	if failure := cert.renewalFailure(); failure != "" {
+		readiness.Diagnose(failure)
+	}
+
+	return readiness, nil
+}
+
+// NotAfter returns the time at which the certificate expires, or nil if the certificate has not been issued.
+func (cert *CertificateResource) NotAfter() *metav1.Time {
+	return cert.Object.Status.NotAfter
 }
 
-// issuerIsReady determines if either an Issuer or a ClusterIssuer resource is ready.
-func issuerIsReady(conditions []cmv1.IssuerCondition) (bool, error) {
+// RenewalTime returns the time at which the certificate will next be renewed, or nil if the certificate
+// has not been issued.
+func (cert *CertificateResource) RenewalTime() *metav1.Time {
+	return cert.Object.Status.RenewalTime
+}
+
+// expiryReadiness adds the expiry of a ready certificate to its readiness and requests that the readiness is
+// checked again once the certificate is due for renewal.  Once it is due, the readiness is checked again at
+// a short interval until the certificate has been renewed.
+func (cert *CertificateResource) expiryReadiness(readiness *Readiness, now time.Time) *Readiness {
+	notAfter, renewalTime := cert.NotAfter(), cert.RenewalTime()
+	if notAfter == nil {
+		return readiness
+	}
+
+	expires := fmt.Sprintf("expires at %s", notAfter.UTC().Format(time.RFC3339))
+
+	if renewalTime != nil && !now.Before(renewalTime.Time) {
+		readiness = &Readiness{
+			State:   readiness.State,
+			Reason:  CertificateReasonRenewalDue,
+			Message: fmt.Sprintf("certificate is due for renewal and %s", expires),
+		}
+
+		readiness.RequeueAfter = certificateRenewalRequeue
+		if untilExpiry := notAfter.Sub(now); untilExpiry > 0 && untilExpiry < certificateRenewalRequeue {
+			readiness.RequeueAfter = untilExpiry
+		}
+
+		return readiness
+	}
+
+	readiness.Message = joinMessages(readiness.Message, expires)
+
+	if renewalTime != nil {
+		readiness.Message = fmt.Sprintf("%s, renews at %s", readiness.Message, renewalTime.UTC().Format(time.RFC3339))
+		readiness.RequeueAfter = renewalTime.Sub(now)
+	}
+
+	return readiness
+}
+
+// renewalFailure returns a description of the failure of the last issuance of the certificate, or an empty
+// string if the last issuance did not fail.
+func (cert *CertificateResource) renewalFailure() string {
+	if cert.Object.Status.LastFailureTime == nil {
+		return ""
+	}
+
+	failure := fmt.Sprintf("issuance failed at %s", cert.Object.Status.LastFailureTime.UTC().Format(time.RFC3339))
+
+	if attempts := cert.Object.Status.FailedIssuanceAttempts; attempts != nil {
+		failure = fmt.Sprintf("%s after %d attempts", failure, *attempts)
+	}
+
+	for _, condition := range cert.Object.Status.Conditions {
+		if condition.Type == cmv1.CertificateConditionIssuing && condition.Message != "" {
+			return fmt.Sprintf("%s: %s", failure, condition.Message)
+		}
+	}
+
+	return failure
+}
+
+// IsReady checks to see if a CertificateRequest is ready.
+func (request *CertificateRequestResource) IsReady() (bool, error) {
+	return readinessIsReady(request.Readiness())
+}
+
+// Readiness checks to see if a CertificateRequest is ready along with the reason for it.  A request which
+// has been denied, is invalid or has failed to be issued has failed.
+func (request *CertificateRequestResource) Readiness() (*Readiness, error) {
+	readiness := Progressing(ReadinessReasonNotReady, "certificaterequest has no ready condition")
+
+	for _, condition := range request.Object.Status.Conditions {
+		// only the ready condition is relevant when it is not true
+		if condition.Status != cmmetav1.ConditionTrue && condition.Type != cmv1.CertificateRequestConditionReady {
+			continue
+		}
+
+		switch condition.Type {
+		case cmv1.CertificateRequestConditionDenied:
+			return Failed(cmv1.CertificateRequestReasonDenied, condition.Message), nil
+		case cmv1.CertificateRequestConditionInvalidRequest:
+			return Failed(condition.Reason, condition.Message), nil
+		case cmv1.CertificateRequestConditionReady:
+			if condition.Reason == cmv1.CertificateRequestReasonFailed {
+				return Failed(condition.Reason, condition.Message), nil
+			}
+
+			readiness = conditionReadiness(condition.Status == cmmetav1.ConditionTrue, condition.Reason, condition.Message)
+		}
+	}
+
+	return readiness, nil
+}
+
+// IsReady checks to see if an Order is ready.
+func (order *OrderResource) IsReady() (bool, error) {
+	return readinessIsReady(order.Readiness())
+}
+
+// Readiness checks to see if an Order is ready along with the reason for it.  An order is ready once it is
+// valid, and has failed once it is invalid, has expired or has errored.
+func (order *OrderResource) Readiness() (*Readiness, error) {
+	return acmeReadiness("order", order.Object.Status.State, order.Object.Status.Reason), nil
+}
+
+// IsReady checks to see if a Challenge is ready.
+func (challenge *ChallengeResource) IsReady() (bool, error) {
+	return readinessIsReady(challenge.Readiness())
+}
+
+// Readiness checks to see if a Challenge is ready along with the reason for it.  A challenge is ready once it
+// is valid, and has failed once it is invalid, has expired or has errored.
+func (challenge *ChallengeResource) Readiness() (*Readiness, error) {
+	readiness := acmeReadiness("challenge", challenge.Object.Status.State, challenge.Object.Status.Reason)
+
+	if !readiness.IsReady() && !readiness.IsFailed() && !challenge.Object.Status.Presented {
+		readiness.Reason = "NotPresented"
+	}
+
+	return readiness, nil
+}
+
+// acmeReadiness determines the readiness of either an Order or a Challenge resource from its state.
+func acmeReadiness(kind string, state cmacmev1.State, reason string) *Readiness {
+	message := joinMessages(fmt.Sprintf("%s is %s", kind, acmeStateName(state)), reason)
+
+	switch state {
+	case cmacmev1.Valid:
+		return Ready(message)
+	case cmacmev1.Invalid, cmacmev1.Expired, cmacmev1.Errored:
+		return Failed(acmeStateReason(state), message)
+	default:
+		return Progressing(acmeStateReason(state), message)
+	}
+}
+
+// acmeStateName returns the name of an ACME state for use in a message.
+func acmeStateName(state cmacmev1.State) string {
+	if state == cmacmev1.Unknown {
+		return "unknown"
+	}
+
+	return string(state)
+}
+
+// acmeStateReason returns the name of an ACME state for use as a reason, such as Pending.
+func acmeStateReason(state cmacmev1.State) string {
+	name := acmeStateName(state)
+
+	return strings.ToUpper(name[:1]) + name[1:]
+}
+
+// issuerReadiness determines the readiness of either an Issuer or a ClusterIssuer resource.
+func issuerReadiness(conditions []cmv1.IssuerCondition) (*Readiness, error) {
 	for _, condition := range conditions {
-		if condition.Type == cmv1.IssuerConditionReady && condition.Status == cmmetav1.ConditionTrue {
-			return true, nil
+		if condition.Type == cmv1.IssuerConditionReady {
+			return conditionReadiness(condition.Status == cmmetav1.ConditionTrue, condition.Reason, condition.Message), nil
 		}
 	}
 
-	return false, nil
+	return Progressing(ReadinessReasonNotReady, "issuer has no ready condition"), nil
+}
+
+// conditionReadiness returns the readiness of a resource from its ready condition.
+func conditionReadiness(ready bool, reason, message string) *Readiness {
+	if ready {
+		return Ready(message)
+	}
+
+	if reason == "" {
+		reason = ReadinessReasonNotReady
+	}
+
+	return Progressing(reason, message)
 }
@@ -0,0 +1,333 @@
+/*
+	SPDX-License-Identifier: MIT
+*/
+
+package resources_test
+
+import (
+	"reflect"
+	"strings"
+	"testing"
+	"time"
+
+	cmacmev1 "github.com/cert-manager/cert-manager/pkg/apis/acme/v1"
+	cmv1 "github.com/cert-manager/cert-manager/pkg/apis/certmanager/v1"
+	cmmetav1 "github.com/cert-manager/cert-manager/pkg/apis/meta/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	"github.com/nukleros/operator-builder-tools/pkg/resources"
+	"github.com/nukleros/operator-builder-tools/pkg/status"
+)
+
+func TestCertificateResource_Readiness(t *testing.T) {
+	t.Parallel()
+
+	now := time.Now()
+	past, renewal, expiry := metav1.NewTime(now.Add(-time.Hour)), metav1.NewTime(now.Add(time.Hour)), metav1.NewTime(now.Add(2*time.Hour))
+	attempts := 2
+
+	ready := cmv1.CertificateCondition{
+		Type:    cmv1.CertificateConditionReady,
+		Status:  cmmetav1.ConditionTrue,
+		Reason:  "Ready",
+		Message: "Certificate is up to date and has not expired",
+	}
+
+	type fields struct {
+		parent *cmv1.Certificate
+	}
+
+	tests := []struct {
+		name             string
+		fields           fields
+		wantState        status.ResourceState
+		wantReason       string
+		wantMessage      string
+		wantDiagnosis    string
+		wantRequeueAfter bool
+		maxRequeueAfter  time.Duration
+	}{
+		{
+			name: "certificate should be ready and requeue at its renewal time",
+			fields: fields{
+				parent: &cmv1.Certificate{
+					Status: cmv1.CertificateStatus{
+						Conditions:  []cmv1.CertificateCondition{ready},
+						NotAfter:    &expiry,
+						RenewalTime: &renewal,
+					},
+				},
+			},
+			wantState:        status.ResourceStateReady,
+			wantReason:       resources.ReadinessReasonReady,
+			wantMessage:      "expires at " + expiry.UTC().Format(time.RFC3339) + ", renews at " + renewal.UTC().Format(time.RFC3339),
+			wantRequeueAfter: true,
+		},
+		{
+			name: "certificate should be ready but due for renewal",
+			fields: fields{
+				parent: &cmv1.Certificate{
+					Status: cmv1.CertificateStatus{
+						Conditions:  []cmv1.CertificateCondition{ready},
+						NotAfter:    &expiry,
+						RenewalTime: &past,
+					},
+				},
+			},
+			wantState:        status.ResourceStateReady,
+			wantReason:       resources.CertificateReasonRenewalDue,
+			wantMessage:      "certificate is due for renewal and expires at " + expiry.UTC().Format(time.RFC3339),
+			wantRequeueAfter: true,
+			maxRequeueAfter:  5 * time.Minute,
+		},
+		{
+			name: "certificate whose renewal failed should be diagnosed",
+			fields: fields{
+				parent: &cmv1.Certificate{
+					Status: cmv1.CertificateStatus{
+						Conditions: []cmv1.CertificateCondition{
+							ready,
+							{
+								Type:    cmv1.CertificateConditionIssuing,
+								Status:  cmmetav1.ConditionFalse,
+								Reason:  "Failed",
+								Message: "The certificate request has failed to complete",
+							},
+						},
+						NotAfter:               &expiry,
+						RenewalTime:            &past,
+						LastFailureTime:        &past,
+						FailedIssuanceAttempts: &attempts,
+					},
+				},
+			},
+			wantState:  status.ResourceStateReady,
+			wantReason: resources.CertificateReasonRenewalDue,
+			wantDiagnosis: "issuance failed at " + past.UTC().Format(time.RFC3339) +
+				" after 2 attempts: The certificate request has failed to complete",
+			wantRequeueAfter: true,
+		},
+		{
+			name: "certificate should not be ready",
+			fields: fields{
+				parent: &cmv1.Certificate{
+					Status: cmv1.CertificateStatus{
+						Conditions: []cmv1.CertificateCondition{
+							{Type: cmv1.CertificateConditionReady, Status: cmmetav1.ConditionFalse, Reason: "Expired"},
+						},
+						NotAfter: &past,
+					},
+				},
+			},
+			wantState:  status.ResourceStateProgressing,
+			wantReason: "Expired",
+		},
+	}
+
+	for _, tt := range tests {
+		tt := tt
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			cert := &resources.CertificateResource{Object: *tt.fields.parent}
+
+			got, err := cert.Readiness()
+			if err != nil {
+				t.Errorf("CertificateResource.Readiness() error = %v", err)
+
+				return
+			}
+
+			if got.State != tt.wantState || got.Reason != tt.wantReason {
+				t.Errorf("CertificateResource.Readiness() = %v, want %v %v", got, tt.wantState, tt.wantReason)
+			}
+
+			if !strings.Contains(got.Message, tt.wantMessage) {
+				t.Errorf("CertificateResource.Readiness() message = %q, want %q", got.Message, tt.wantMessage)
+			}
+
+			if got.Diagnosis != tt.wantDiagnosis {
+				t.Errorf("CertificateResource.Readiness() diagnosis = %q, want %q", got.Diagnosis, tt.wantDiagnosis)
+			}
+
+			if (got.RequeueAfter > 0) != tt.wantRequeueAfter {
+				t.Errorf("CertificateResource.Readiness() requeue after = %v, want %v", got.RequeueAfter, tt.wantRequeueAfter)
+			}
+
+			if tt.maxRequeueAfter > 0 && got.RequeueAfter > tt.maxRequeueAfter {
+				t.Errorf("CertificateResource.Readiness() requeue after = %v, want at most %v", got.RequeueAfter, tt.maxRequeueAfter)
+			}
+		})
+	}
+}
+
+func TestCertificateRequestResource_Readiness(t *testing.T) {
+	t.Parallel()
+
+	condition := func(
+		conditionType cmv1.CertificateRequestConditionType,
+		status cmmetav1.ConditionStatus,
+		reason string,
+	) cmv1.CertificateRequestCondition {
+		return cmv1.CertificateRequestCondition{Type: conditionType, Status: status, Reason: reason, Message: reason}
+	}
+
+	tests := []struct {
+		name       string
+		conditions []cmv1.CertificateRequestCondition
+		want       *resources.Readiness
+	}{
+		{
+			name: "certificaterequest should be ready",
+			conditions: []cmv1.CertificateRequestCondition{
+				condition(cmv1.CertificateRequestConditionApproved, cmmetav1.ConditionTrue, "Approved"),
+				condition(cmv1.CertificateRequestConditionReady, cmmetav1.ConditionTrue, cmv1.CertificateRequestReasonIssued),
+			},
+			want: resources.Ready(cmv1.CertificateRequestReasonIssued),
+		},
+		{
+			name: "certificaterequest should not be ready (pending)",
+			conditions: []cmv1.CertificateRequestCondition{
+				condition(cmv1.CertificateRequestConditionReady, cmmetav1.ConditionFalse, cmv1.CertificateRequestReasonPending),
+			},
+			want: resources.Progressing(cmv1.CertificateRequestReasonPending, cmv1.CertificateRequestReasonPending),
+		},
+		{
+			name: "certificaterequest should have failed (failed)",
+			conditions: []cmv1.CertificateRequestCondition{
+				condition(cmv1.CertificateRequestConditionReady, cmmetav1.ConditionFalse, cmv1.CertificateRequestReasonFailed),
+			},
+			want: resources.Failed(cmv1.CertificateRequestReasonFailed, cmv1.CertificateRequestReasonFailed),
+		},
+		{
+			name: "certificaterequest should have failed (denied)",
+			conditions: []cmv1.CertificateRequestCondition{
+				condition(cmv1.CertificateRequestConditionDenied, cmmetav1.ConditionTrue, "Denied"),
+				condition(cmv1.CertificateRequestConditionReady, cmmetav1.ConditionFalse, cmv1.CertificateRequestReasonPending),
+			},
+			want: resources.Failed(cmv1.CertificateRequestReasonDenied, "Denied"),
+		},
+		{
+			name: "certificaterequest should not be ready (no conditions)",
+			want: resources.Progressing(resources.ReadinessReasonNotReady, "certificaterequest has no ready condition"),
+		},
+	}
+
+	for _, tt := range tests {
+		tt := tt
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			request := &resources.CertificateRequestResource{
+				Object: cmv1.CertificateRequest{Status: cmv1.CertificateRequestStatus{Conditions: tt.conditions}},
+			}
+
+			got, err := request.Readiness()
+			if err != nil {
+				t.Errorf("CertificateRequestResource.Readiness() error = %v", err)
+
+				return
+			}
+
+			if !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("CertificateRequestResource.Readiness() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestOrderResource_Readiness(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name   string
+		status cmacmev1.OrderStatus
+		want   *resources.Readiness
+	}{
+		{
+			name:   "order should be ready",
+			status: cmacmev1.OrderStatus{State: cmacmev1.Valid},
+			want:   resources.Ready("order is valid"),
+		},
+		{
+			name:   "order should not be ready (pending)",
+			status: cmacmev1.OrderStatus{State: cmacmev1.Pending},
+			want:   resources.Progressing("Pending", "order is pending"),
+		},
+		{
+			name:   "order should have failed (errored)",
+			status: cmacmev1.OrderStatus{State: cmacmev1.Errored, Reason: "Failed to finalize order"},
+			want:   resources.Failed("Errored", "order is errored; Failed to finalize order"),
+		},
+		{
+			name: "order should not be ready (empty)",
+			want: resources.Progressing("Unknown", "order is unknown"),
+		},
+	}
+
+	for _, tt := range tests {
+		tt := tt
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			order := &resources.OrderResource{Object: cmacmev1.Order{Status: tt.status}}
+
+			got, err := order.Readiness()
+			if err != nil {
+				t.Errorf("OrderResource.Readiness() error = %v", err)
+
+				return
+			}
+
+			if !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("OrderResource.Readiness() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestChallengeResource_Readiness(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name   string
+		status cmacmev1.ChallengeStatus
+		want   *resources.Readiness
+	}{
+		{
+			name:   "challenge should be ready",
+			status: cmacmev1.ChallengeStatus{State: cmacmev1.Valid, Presented: true},
+			want:   resources.Ready("challenge is valid"),
+		},
+		{
+			name:   "challenge should not be ready (not presented)",
+			status: cmacmev1.ChallengeStatus{State: cmacmev1.Pending, Reason: "Waiting for HTTP-01 challenge propagation"},
+			want:   resources.Progressing("NotPresented", "challenge is pending; Waiting for HTTP-01 challenge propagation"),
+		},
+		{
+			name:   "challenge should have failed (invalid)",
+			status: cmacmev1.ChallengeStatus{State: cmacmev1.Invalid, Presented: true, Reason: "404 from the challenge URL"},
+			want:   resources.Failed("Invalid", "challenge is invalid; 404 from the challenge URL"),
+		},
+	}
+
+	for _, tt := range tests {
+		tt := tt
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			challenge := &resources.ChallengeResource{Object: cmacmev1.Challenge{Status: tt.status}}
+
+			got, err := challenge.Readiness()
+			if err != nil {
+				t.Errorf("ChallengeResource.Readiness() error = %v", err)
+
+				return
+			}
+
+			if !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("ChallengeResource.Readiness() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
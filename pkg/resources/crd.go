@@ -5,8 +5,14 @@
 package resources
 
 import (
+	"fmt"
+
+	v1 "k8s.io/api/core/v1"
 	extensionsv1 "k8s.io/apiextensions-apiserver/pkg/apis/apiextensions/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	"github.com/nukleros/operator-builder-tools/pkg/controller/workload"
 )
 
 const (
@@ -14,9 +20,12 @@ const (
 	CustomResourceDefinitionVersion = "apiextensions.k8s.io/v1"
 )
 
-// CRDResource represents a Kubernetes CustomResourceDefinition object.
+// CRDResource represents a Kubernetes CustomResourceDefinition object.  The reconciler and request are
+// optional and are used to look up the endpoints of the conversion webhook service of the CRD.
 type CRDResource struct {
-	Object extensionsv1.CustomResourceDefinition
+	Object     extensionsv1.CustomResourceDefinition
+	Reconciler workload.Reconciler
+	Request    *workload.Request
 }
 
 // NewCRDResource creates and returns a new CRDResource.
@@ -30,12 +39,97 @@ func NewCRDResource(object client.Object) (*CRDResource, error) {
 	return &CRDResource{Object: *crd}, nil
 }
 
+// NewCRDResourceFromReconciler creates and returns a new CRDResource which is able to look up the
+// endpoints of its conversion webhook service.
+func NewCRDResourceFromReconciler(r workload.Reconciler, req *workload.Request, object client.Object) (*CRDResource, error) {
+	crd, err := NewCRDResource(object)
+	if err != nil {
+		return nil, err
+	}
+
+	crd.Reconciler = r
+	crd.Request = req
+
+	return crd, nil
+}
+
 // IsReady performs the logic to determine if a CRD is ready.
 func (crd *CRDResource) IsReady() (bool, error) {
+	return readinessIsReady(crd.Readiness())
+}
+
+// Readiness performs the logic to determine if a CRD is ready along with the reason for it.  A CRD is
+// ready once it is established and its names are accepted.  A CRD which uses a conversion webhook must
+// also have the minimum number of ready endpoints set by the MinReadyEndpointsAnnotation for the conversion
+// webhook service when a reconciler is available.
+func (crd *CRDResource) Readiness() (*Readiness, error) {
 	// if we have a name that is empty, we know we did not find the object
 	if crd.Object.Name == "" {
-		return false, nil
+		return Progressing("NotFound", "customresourcedefinition not found"), nil
+	}
+
+	conditionTypes := []extensionsv1.CustomResourceDefinitionConditionType{
+		extensionsv1.NamesAccepted,
+		extensionsv1.Established,
+	}
+
+	for _, conditionType := range conditionTypes {
+		if readiness := crd.conditionReadiness(conditionType); !readiness.IsReady() {
+			return readiness, nil
+		}
+	}
+
+	service := crd.conversionService()
+	if service == nil || crd.Reconciler == nil || crd.Request == nil {
+		return Ready("customresourcedefinition is established"), nil
+	}
+
+	minReady, err := minReadyEndpoints(&crd.Object)
+	if err != nil {
+		return nil, err
 	}
 
-	return true, nil
+	readiness, err := serviceReadiness(crd.Reconciler, crd.Request, service, minReady)
+	if err != nil {
+		return nil, fmt.Errorf("unable to determine conversion webhook endpoint readiness - %w", err)
+	}
+
+	if !readiness.IsReady() {
+		return Progressing("ConversionWebhookNotReady", readiness.Message), nil
+	}
+
+	return Ready("customresourcedefinition is established and its conversion webhook is ready"), nil
+}
+
+// conditionReadiness returns the readiness of the CRD from a single condition.
+func (crd *CRDResource) conditionReadiness(conditionType extensionsv1.CustomResourceDefinitionConditionType) *Readiness {
+	for _, condition := range crd.Object.Status.Conditions {
+		if condition.Type == conditionType {
+			return conditionReadiness(condition.Status == extensionsv1.ConditionTrue, condition.Reason, condition.Message)
+		}
+	}
+
+	return Progressing(string(conditionType)+"Unknown", fmt.Sprintf("condition %s has not been reported", conditionType))
+}
+
+// conversionService returns the service which backs the conversion webhook of the CRD, or nil when the
+// CRD does not use a conversion webhook service.
+func (crd *CRDResource) conversionService() *ServiceResource {
+	conversion := crd.Object.Spec.Conversion
+	if conversion == nil || conversion.Strategy != extensionsv1.WebhookConverter {
+		return nil
+	}
+
+	if conversion.Webhook == nil || conversion.Webhook.ClientConfig == nil || conversion.Webhook.ClientConfig.Service == nil {
+		return nil
+	}
+
+	return &ServiceResource{
+		Object: v1.Service{
+			ObjectMeta: metav1.ObjectMeta{
+				Name:      conversion.Webhook.ClientConfig.Service.Name,
+				Namespace: conversion.Webhook.ClientConfig.Service.Namespace,
+			},
+		},
+	}
 }
@@ -5,15 +5,158 @@
 package resources_test
 
 import (
+	"context"
 	"reflect"
 	"testing"
 
 	extensionsv1 "k8s.io/apiextensions-apiserver/pkg/apis/apiextensions/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"sigs.k8s.io/controller-runtime/pkg/client"
 
+	"github.com/nukleros/operator-builder-tools/pkg/controller/workload"
 	"github.com/nukleros/operator-builder-tools/pkg/resources"
 )
 
+func TestCRDResource_IsReady(t *testing.T) {
+	t.Parallel()
+
+	established := extensionsv1.CustomResourceDefinitionStatus{
+		Conditions: []extensionsv1.CustomResourceDefinitionCondition{
+			{Type: extensionsv1.NamesAccepted, Status: extensionsv1.ConditionTrue},
+			{Type: extensionsv1.Established, Status: extensionsv1.ConditionTrue},
+		},
+	}
+
+	conversion := extensionsv1.CustomResourceDefinitionSpec{
+		Conversion: &extensionsv1.CustomResourceConversion{
+			Strategy: extensionsv1.WebhookConverter,
+			Webhook: &extensionsv1.WebhookConversion{
+				ClientConfig: &extensionsv1.WebhookClientConfig{
+					Service: &extensionsv1.ServiceReference{Name: "webhook", Namespace: "system"},
+				},
+			},
+		},
+	}
+
+	readyEndpoints := newEndpointSlice("system", "webhook", true)
+
+	notReadyEndpoints := newEndpointSlice("system", "webhook", false)
+
+	type fields struct {
+		parent     *extensionsv1.CustomResourceDefinition
+		reconciler workload.Reconciler
+	}
+
+	tests := []struct {
+		name    string
+		fields  fields
+		want    bool
+		wantErr bool
+	}{
+		{
+			name:    "crd should be ready",
+			want:    true,
+			wantErr: false,
+			fields: fields{
+				parent: &extensionsv1.CustomResourceDefinition{
+					ObjectMeta: metav1.ObjectMeta{Name: "ready"},
+					Status:     established,
+				},
+			},
+		},
+		{
+			name:    "crd should be ready (conversion webhook endpoints ready)",
+			want:    true,
+			wantErr: false,
+			fields: fields{
+				parent: &extensionsv1.CustomResourceDefinition{
+					ObjectMeta: metav1.ObjectMeta{Name: "conversion-ready"},
+					Spec:       conversion,
+					Status:     established,
+				},
+				reconciler: newClientReconciler(readyEndpoints),
+			},
+		},
+		{
+			name:    "crd should not be ready (conversion webhook endpoints not ready)",
+			want:    false,
+			wantErr: false,
+			fields: fields{
+				parent: &extensionsv1.CustomResourceDefinition{
+					ObjectMeta: metav1.ObjectMeta{Name: "conversion-not-ready"},
+					Spec:       conversion,
+					Status:     established,
+				},
+				reconciler: newClientReconciler(notReadyEndpoints),
+			},
+		},
+		{
+			name:    "crd should not be ready (not established)",
+			want:    false,
+			wantErr: false,
+			fields: fields{
+				parent: &extensionsv1.CustomResourceDefinition{
+					ObjectMeta: metav1.ObjectMeta{Name: "not-established"},
+					Status: extensionsv1.CustomResourceDefinitionStatus{
+						Conditions: []extensionsv1.CustomResourceDefinitionCondition{
+							{Type: extensionsv1.NamesAccepted, Status: extensionsv1.ConditionTrue},
+							{Type: extensionsv1.Established, Status: extensionsv1.ConditionFalse},
+						},
+					},
+				},
+			},
+		},
+		{
+			name:    "crd should not be ready (names not accepted)",
+			want:    false,
+			wantErr: false,
+			fields: fields{
+				parent: &extensionsv1.CustomResourceDefinition{
+					ObjectMeta: metav1.ObjectMeta{Name: "names-not-accepted"},
+					Status: extensionsv1.CustomResourceDefinitionStatus{
+						Conditions: []extensionsv1.CustomResourceDefinitionCondition{
+							{Type: extensionsv1.NamesAccepted, Status: extensionsv1.ConditionFalse, Reason: "NameConflict"},
+						},
+					},
+				},
+			},
+		},
+		{
+			name:    "crd should not be ready (empty)",
+			want:    false,
+			wantErr: false,
+			fields: fields{
+				parent: &extensionsv1.CustomResourceDefinition{},
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		tt := tt
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			crd := &resources.CRDResource{Object: *tt.fields.parent}
+
+			if tt.fields.reconciler != nil {
+				crd.Reconciler = tt.fields.reconciler
+				crd.Request = &workload.Request{Context: context.Background()}
+			}
+
+			got, err := crd.IsReady()
+			if (err != nil) != tt.wantErr {
+				t.Errorf("CRDResource.IsReady() error = %v, wantErr %v", err, tt.wantErr)
+
+				return
+			}
+
+			if got != tt.want {
+				t.Errorf("CRDResource.IsReady() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
 func TestNewCRDResource(t *testing.T) {
 	t.Parallel()
 
@@ -5,7 +5,11 @@
 package resources
 
 import (
+	"fmt"
+
 	appsv1 "k8s.io/api/apps/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/util/intstr"
 	"sigs.k8s.io/controller-runtime/pkg/client"
 )
 
@@ -14,6 +18,10 @@ const (
 	DaemonSetVersion = "apps/v1"
 )
 
+// AllowZeroScheduledAnnotation may be set to "true" on a DaemonSet whose node selector may legitimately match
+// no nodes, so that it is ready when no pods are scheduled.
+const AllowZeroScheduledAnnotation = "operator-builder.nukleros.io/allow-zero-scheduled"
+
 // DaemonSetResource represents a Kubernetes DaemonSet object.
 type DaemonSetResource struct {
 	Object appsv1.DaemonSet
@@ -31,14 +39,82 @@ func NewDaemonSetResource(object client.Object) (*DaemonSetResource, error) {
 	return &DaemonSetResource{Object: *daemonSet}, nil
 }
 
+// PodSelector returns the namespace and selector of the pods of a DaemonSet.
+func (daemonSet *DaemonSetResource) PodSelector() (string, *metav1.LabelSelector) {
+	return daemonSet.Object.Namespace, daemonSet.Object.Spec.Selector
+}
+
 // IsReady checks to see if a DaemonSet is ready.
 func (daemonSet *DaemonSetResource) IsReady() (bool, error) {
-	// ensure the desired number is scheduled and ready
-	if daemonSet.Object.Status.DesiredNumberScheduled == daemonSet.Object.Status.NumberReady {
-		if daemonSet.Object.Status.NumberReady > 0 && daemonSet.Object.Status.NumberUnavailable < 1 {
-			return true, nil
+	return readinessIsReady(daemonSet.Readiness())
+}
+
+// Readiness checks to see if a DaemonSet is ready along with the reason for it.  A DaemonSet is ready once
+// its rolling update has finished and no more pods are unavailable than its maxUnavailable tolerates.
+func (daemonSet *DaemonSetResource) Readiness() (*Readiness, error) {
+	// if we have a name that is empty, we know we did not find the object
+	if daemonSet.Object.Name == "" {
+		return Progressing("NotFound", "daemonset not found"), nil
+	}
+
+	// rely on observed generation to give us a proper status
+	if daemonSet.Object.Generation > daemonSet.Object.Status.ObservedGeneration {
+		return Progressing("ObservedGenerationOutdated", "daemonset spec has not been observed"), nil
+	}
+
+	status := daemonSet.Object.Status
+
+	// a daemonset which matches no nodes is only ready when requested
+	if status.DesiredNumberScheduled == 0 {
+		if daemonSet.Object.GetAnnotations()[AllowZeroScheduledAnnotation] == "true" {
+			return Ready("no nodes match the daemonset"), nil
 		}
+
+		return Progressing("NoPodsScheduled", "no nodes match the daemonset"), nil
+	}
+
+	// check to see if the rolling update has finished.  Pods are not updated by the controller when using
+	// the OnDelete strategy.
+	if daemonSet.Object.Spec.UpdateStrategy.Type != appsv1.OnDeleteDaemonSetStrategyType &&
+		status.UpdatedNumberScheduled < status.DesiredNumberScheduled {
+		return Progressing(
+			"RolloutInProgress",
+			fmt.Sprintf("%d out of %d new pods have been updated", status.UpdatedNumberScheduled, status.DesiredNumberScheduled),
+		), nil
+	}
+
+	available := fmt.Sprintf("%d/%d scheduled pods available", status.NumberAvailable, status.DesiredNumberScheduled)
+
+	maxUnavailable, err := daemonSet.maxUnavailable()
+	if err != nil {
+		return nil, err
+	}
+
+	if int(status.DesiredNumberScheduled-status.NumberAvailable) > maxUnavailable {
+		return Progressing("PodsNotAvailable", available), nil
+	}
+
+	return Ready(available), nil
+}
+
+// maxUnavailable returns the number of scheduled pods which may be unavailable for the DaemonSet to be
+// ready.  At least one scheduled pod must always be available.
+func (daemonSet *DaemonSetResource) maxUnavailable() (int, error) {
+	rollingUpdate := daemonSet.Object.Spec.UpdateStrategy.RollingUpdate
+	if rollingUpdate == nil || rollingUpdate.MaxUnavailable == nil {
+		return 0, nil
+	}
+
+	desired := int(daemonSet.Object.Status.DesiredNumberScheduled)
+
+	maxUnavailable, err := intstr.GetScaledValueFromIntOrPercent(rollingUpdate.MaxUnavailable, desired, true)
+	if err != nil {
+		return 0, fmt.Errorf("unable to determine max unavailable pods for daemonset %s - %w", daemonSet.Object.Name, err)
+	}
+
+	if maxUnavailable >= desired {
+		maxUnavailable = desired - 1
 	}
 
-	return false, nil
+	return maxUnavailable, nil
 }
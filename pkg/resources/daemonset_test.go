@@ -9,6 +9,8 @@ import (
 	"testing"
 
 	appsv1 "k8s.io/api/apps/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/util/intstr"
 	"sigs.k8s.io/controller-runtime/pkg/client"
 
 	"github.com/nukleros/operator-builder-tools/pkg/resources"
@@ -57,3 +59,161 @@ func TestNewDaemonSetResource(t *testing.T) {
 		})
 	}
 }
+
+func TestDaemonSetResource_Readiness(t *testing.T) {
+	t.Parallel()
+
+	maxUnavailable, maxUnavailablePercent := intstr.FromInt32(1), intstr.FromString("50%")
+
+	newDaemonSet := func(
+		annotations map[string]string,
+		strategy appsv1.DaemonSetUpdateStrategy,
+		status appsv1.DaemonSetStatus,
+	) *appsv1.DaemonSet {
+		status.ObservedGeneration = 1
+
+		return &appsv1.DaemonSet{
+			ObjectMeta: metav1.ObjectMeta{Name: "daemonset", Namespace: "daemonset", Generation: 1, Annotations: annotations},
+			Spec:       appsv1.DaemonSetSpec{UpdateStrategy: strategy},
+			Status:     status,
+		}
+	}
+
+	rollingUpdate := func(maxUnavailable *intstr.IntOrString) appsv1.DaemonSetUpdateStrategy {
+		return appsv1.DaemonSetUpdateStrategy{
+			Type:          appsv1.RollingUpdateDaemonSetStrategyType,
+			RollingUpdate: &appsv1.RollingUpdateDaemonSet{MaxUnavailable: maxUnavailable},
+		}
+	}
+
+	type fields struct {
+		parent *appsv1.DaemonSet
+	}
+
+	tests := []struct {
+		name   string
+		fields fields
+		want   *resources.Readiness
+	}{
+		{
+			name: "daemonset should be ready",
+			fields: fields{
+				parent: newDaemonSet(nil, rollingUpdate(&maxUnavailable), appsv1.DaemonSetStatus{
+					DesiredNumberScheduled: 3,
+					UpdatedNumberScheduled: 3,
+					NumberAvailable:        3,
+				}),
+			},
+			want: resources.Ready("3/3 scheduled pods available"),
+		},
+		{
+			name: "daemonset should be ready (unavailable pods within max unavailable)",
+			fields: fields{
+				parent: newDaemonSet(nil, rollingUpdate(&maxUnavailablePercent), appsv1.DaemonSetStatus{
+					DesiredNumberScheduled: 4,
+					UpdatedNumberScheduled: 4,
+					NumberAvailable:        2,
+				}),
+			},
+			want: resources.Ready("2/4 scheduled pods available"),
+		},
+		{
+			name: "daemonset should not be ready (unavailable pods exceed max unavailable)",
+			fields: fields{
+				parent: newDaemonSet(nil, rollingUpdate(&maxUnavailable), appsv1.DaemonSetStatus{
+					DesiredNumberScheduled: 3,
+					UpdatedNumberScheduled: 3,
+					NumberAvailable:        1,
+				}),
+			},
+			want: resources.Progressing("PodsNotAvailable", "1/3 scheduled pods available"),
+		},
+		{
+			name: "daemonset should not be ready (no pods available on a single node)",
+			fields: fields{
+				parent: newDaemonSet(nil, rollingUpdate(&maxUnavailable), appsv1.DaemonSetStatus{
+					DesiredNumberScheduled: 1,
+					UpdatedNumberScheduled: 1,
+				}),
+			},
+			want: resources.Progressing("PodsNotAvailable", "0/1 scheduled pods available"),
+		},
+		{
+			name: "daemonset should not be ready (rolling update in progress)",
+			fields: fields{
+				parent: newDaemonSet(nil, rollingUpdate(&maxUnavailable), appsv1.DaemonSetStatus{
+					DesiredNumberScheduled: 3,
+					UpdatedNumberScheduled: 1,
+					NumberAvailable:        3,
+				}),
+			},
+			want: resources.Progressing("RolloutInProgress", "1 out of 3 new pods have been updated"),
+		},
+		{
+			name: "daemonset should be ready (on delete strategy)",
+			fields: fields{
+				parent: newDaemonSet(nil, appsv1.DaemonSetUpdateStrategy{Type: appsv1.OnDeleteDaemonSetStrategyType}, appsv1.DaemonSetStatus{
+					DesiredNumberScheduled: 3,
+					UpdatedNumberScheduled: 1,
+					NumberAvailable:        3,
+				}),
+			},
+			want: resources.Ready("3/3 scheduled pods available"),
+		},
+		{
+			name: "daemonset should not be ready (no pods scheduled)",
+			fields: fields{
+				parent: newDaemonSet(nil, rollingUpdate(&maxUnavailable), appsv1.DaemonSetStatus{}),
+			},
+			want: resources.Progressing("NoPodsScheduled", "no nodes match the daemonset"),
+		},
+		{
+			name: "daemonset should be ready (no pods scheduled when allowed)",
+			fields: fields{
+				parent: newDaemonSet(
+					map[string]string{resources.AllowZeroScheduledAnnotation: "true"},
+					rollingUpdate(&maxUnavailable),
+					appsv1.DaemonSetStatus{},
+				),
+			},
+			want: resources.Ready("no nodes match the daemonset"),
+		},
+		{
+			name: "daemonset should not be ready (generation not observed)",
+			fields: fields{
+				parent: &appsv1.DaemonSet{
+					ObjectMeta: metav1.ObjectMeta{Name: "daemonset", Namespace: "daemonset", Generation: 2},
+					Status:     appsv1.DaemonSetStatus{ObservedGeneration: 1},
+				},
+			},
+			want: resources.Progressing("ObservedGenerationOutdated", "daemonset spec has not been observed"),
+		},
+		{
+			name: "daemonset should not be ready (empty)",
+			fields: fields{
+				parent: &appsv1.DaemonSet{},
+			},
+			want: resources.Progressing("NotFound", "daemonset not found"),
+		},
+	}
+
+	for _, tt := range tests {
+		tt := tt
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			daemonSet := &resources.DaemonSetResource{Object: *tt.fields.parent}
+
+			got, err := daemonSet.Readiness()
+			if err != nil {
+				t.Errorf("DaemonSetResource.Readiness() error = %v", err)
+
+				return
+			}
+
+			if !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("DaemonSetResource.Readiness() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
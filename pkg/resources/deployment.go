@@ -5,8 +5,10 @@
 package resources
 
 import (
+	"fmt"
+
 	appsv1 "k8s.io/api/apps/v1"
-	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"sigs.k8s.io/controller-runtime/pkg/client"
 )
 
@@ -15,6 +17,10 @@ const (
 	DeploymentVersion = "apps/v1"
 )
 
+// deploymentProgressDeadlineExceeded is the reason of the Progressing condition of a Deployment whose
+// rollout has not progressed within its progress deadline.
+const deploymentProgressDeadlineExceeded = "ProgressDeadlineExceeded"
+
 // DeploymentResource represents a Kubernetes Deployment object.
 type DeploymentResource struct {
 	Object appsv1.Deployment
@@ -32,29 +38,62 @@ func NewDeploymentResource(object client.Object) (*DeploymentResource, error) {
 	return &DeploymentResource{Object: *deployment}, nil
 }
 
+// PodSelector returns the namespace and selector of the pods of a Deployment.
+func (deployment *DeploymentResource) PodSelector() (string, *metav1.LabelSelector) {
+	return deployment.Object.Namespace, deployment.Object.Spec.Selector
+}
+
 // IsReady performs the logic to determine if a Deployment is ready.
 func (deployment *DeploymentResource) IsReady() (bool, error) {
+	return readinessIsReady(deployment.Readiness())
+}
+
+// Readiness performs the logic to determine if a Deployment is ready along with the reason for it.  A
+// Deployment is ready once its latest rollout has completed, matching the semantics of kubectl rollout
+// status, and has failed once its rollout has exceeded its progress deadline.
+func (deployment *DeploymentResource) Readiness() (*Readiness, error) {
 	// if we have a name that is empty, we know we did not find the object
 	if deployment.Object.Name == "" {
-		return false, nil
-	}
-
-	// check the status for a ready deployment
-	if deployment.Object.Status.ReadyReplicas != deployment.Object.Status.Replicas {
-		return false, nil
+		return Progressing("NotFound", "deployment not found"), nil
 	}
 
-	// ensure that there are no replicas that are unavailable
-	if deployment.Object.Status.UnavailableReplicas > 0 {
-		return false, nil
+	// ensure the deployment controller has observed the latest spec
+	if deployment.Object.Generation > deployment.Object.Status.ObservedGeneration {
+		return Progressing("RolloutNotObserved", "waiting for deployment spec update to be observed"), nil
 	}
 
-	// ensure the ready condition is true
+	// report a rollout which has stalled as failed
 	for _, condition := range deployment.Object.Status.Conditions {
-		if condition.Type == appsv1.DeploymentAvailable && condition.Status == corev1.ConditionTrue {
-			return true, nil
+		if condition.Type == appsv1.DeploymentProgressing && condition.Reason == deploymentProgressDeadlineExceeded {
+			return Failed(condition.Reason, fmt.Sprintf("deployment exceeded its progress deadline: %s", condition.Message)), nil
 		}
 	}
 
-	return false, nil
+	status := deployment.Object.Status
+
+	// ensure all replicas have been updated to the latest pod template
+	if deployment.Object.Spec.Replicas != nil && status.UpdatedReplicas < *deployment.Object.Spec.Replicas {
+		return Progressing(
+			"ReplicasNotUpdated",
+			fmt.Sprintf("%d out of %d new replicas have been updated", status.UpdatedReplicas, *deployment.Object.Spec.Replicas),
+		), nil
+	}
+
+	// ensure the replicas of previous rollouts have terminated
+	if status.Replicas > status.UpdatedReplicas {
+		return Progressing(
+			"OldReplicasPending",
+			fmt.Sprintf("%d old replicas are pending termination", status.Replicas-status.UpdatedReplicas),
+		), nil
+	}
+
+	// ensure the updated replicas are available
+	if status.AvailableReplicas < status.UpdatedReplicas {
+		return Progressing(
+			"ReplicasNotAvailable",
+			fmt.Sprintf("%d of %d updated replicas are available", status.AvailableReplicas, status.UpdatedReplicas),
+		), nil
+	}
+
+	return Ready(fmt.Sprintf("%d/%d replicas available", status.AvailableReplicas, status.UpdatedReplicas)), nil
 }
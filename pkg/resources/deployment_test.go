@@ -9,6 +9,7 @@ import (
 	"testing"
 
 	appsv1 "k8s.io/api/apps/v1"
+	v1 "k8s.io/api/core/v1"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"sigs.k8s.io/controller-runtime/pkg/client"
 
@@ -47,11 +48,114 @@ func TestDeploymentResource_IsReady(t *testing.T) {
 					Status: appsv1.DeploymentStatus{
 						Replicas:           randomInt,
 						ReadyReplicas:      randomInt,
+						UpdatedReplicas:    randomInt,
+						AvailableReplicas:  randomInt,
 						ObservedGeneration: int64(randomInt),
 					},
 				},
 			},
 		},
+		{
+			name:    "deployment should not be ready (generation not observed)",
+			want:    false,
+			wantErr: false,
+			fields: fields{
+				parent: &appsv1.Deployment{
+					ObjectMeta: metav1.ObjectMeta{
+						Name:       "not-ready-generation",
+						Namespace:  "not-ready-generation",
+						Generation: int64(randomInt + 1),
+					},
+					Spec: appsv1.DeploymentSpec{
+						Replicas: &randomInt,
+					},
+					Status: appsv1.DeploymentStatus{
+						Replicas:           randomInt,
+						ReadyReplicas:      randomInt,
+						UpdatedReplicas:    randomInt,
+						AvailableReplicas:  randomInt,
+						ObservedGeneration: int64(randomInt),
+					},
+				},
+			},
+		},
+		{
+			name:    "deployment should not be ready (old replicas pending termination)",
+			want:    false,
+			wantErr: false,
+			fields: fields{
+				parent: &appsv1.Deployment{
+					ObjectMeta: metav1.ObjectMeta{
+						Name:       "not-ready-rollout",
+						Namespace:  "not-ready-rollout",
+						Generation: int64(randomInt),
+					},
+					Spec: appsv1.DeploymentSpec{
+						Replicas: &randomInt,
+					},
+					Status: appsv1.DeploymentStatus{
+						Replicas:           randomInt + 1,
+						ReadyReplicas:      randomInt + 1,
+						UpdatedReplicas:    randomInt,
+						AvailableReplicas:  randomInt + 1,
+						ObservedGeneration: int64(randomInt),
+					},
+				},
+			},
+		},
+		{
+			name:    "deployment should not be ready (updated replicas unavailable)",
+			want:    false,
+			wantErr: false,
+			fields: fields{
+				parent: &appsv1.Deployment{
+					ObjectMeta: metav1.ObjectMeta{
+						Name:       "not-ready-available",
+						Namespace:  "not-ready-available",
+						Generation: int64(randomInt),
+					},
+					Spec: appsv1.DeploymentSpec{
+						Replicas: &randomInt,
+					},
+					Status: appsv1.DeploymentStatus{
+						Replicas:           randomInt,
+						UpdatedReplicas:    randomInt,
+						ObservedGeneration: int64(randomInt),
+					},
+				},
+			},
+		},
+		{
+			name:    "deployment should have failed (progress deadline exceeded)",
+			want:    false,
+			wantErr: true,
+			fields: fields{
+				parent: &appsv1.Deployment{
+					ObjectMeta: metav1.ObjectMeta{
+						Name:       "failed-deadline",
+						Namespace:  "failed-deadline",
+						Generation: int64(randomInt),
+					},
+					Spec: appsv1.DeploymentSpec{
+						Replicas: &randomInt,
+					},
+					Status: appsv1.DeploymentStatus{
+						Replicas:           randomInt + 1,
+						UpdatedReplicas:    randomInt,
+						AvailableReplicas:  randomInt,
+						ObservedGeneration: int64(randomInt),
+						Conditions: []appsv1.DeploymentCondition{
+							{
+								Type:    appsv1.DeploymentProgressing,
+								Status:  v1.ConditionFalse,
+								Reason:  "ProgressDeadlineExceeded",
+								Message: `ReplicaSet "failed-deadline-5d8f9c" has timed out progressing.`,
+							},
+						},
+					},
+				},
+			},
+		},
 		{
 			name:    "deployment should not be ready (replicas)",
 			want:    false,
@@ -0,0 +1,172 @@
+/*
+	SPDX-License-Identifier: MIT
+*/
+
+package resources
+
+import (
+	"fmt"
+	"sort"
+	"strings"
+
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	"github.com/nukleros/operator-builder-tools/pkg/controller/workload"
+)
+
+// containerWaitingProblems are the reasons of a waiting container which will not resolve on their own.
+//
+//nolint:gochecknoglobals
+var containerWaitingProblems = map[string]bool{
+	crashLoopBackOffReason:       true,
+	"ImagePullBackOff":           true,
+	"ErrImagePull":               true,
+	"InvalidImageName":           true,
+	"CreateContainerConfigError": true,
+	"CreateContainerError":       true,
+}
+
+const (
+	// crashLoopBackOffReason is the reason of a waiting container which is repeatedly exiting.
+	crashLoopBackOffReason = "CrashLoopBackOff"
+
+	// oomKilledReason is the reason of a container which was terminated for exceeding its memory limit.
+	oomKilledReason = "OOMKilled"
+)
+
+// diagnosePods lists the pods of a resource which is not ready and adds a diagnosis of the problems with
+// those pods to its readiness.  Resources whose checker does not implement PodSelector are left unchanged.
+func diagnosePods(r workload.Reconciler, req *workload.Request, checker ResourceChecker, readiness *Readiness) error {
+	podSelector, ok := checker.(PodSelector)
+	if !ok || readiness.IsReady() {
+		return nil
+	}
+
+	namespace, labelSelector := podSelector.PodSelector()
+	if labelSelector == nil {
+		return nil
+	}
+
+	selector, err := metav1.LabelSelectorAsSelector(labelSelector)
+	if err != nil {
+		return fmt.Errorf("unable to parse pod selector - %w", err)
+	}
+
+	pods := &corev1.PodList{}
+	if err := r.List(
+		req.Context,
+		pods,
+		client.InNamespace(namespace),
+		client.MatchingLabelsSelector{Selector: selector},
+	); err != nil {
+		return fmt.Errorf("unable to list pods for diagnosis - %w", err)
+	}
+
+	readiness.Diagnose(DiagnosePods(pods.Items...))
+
+	return nil
+}
+
+// DiagnosePods returns a concise diagnosis of the problems with a set of pods, such as a container which is
+// in CrashLoopBackOff or a pod which is unschedulable.  The first problem is described along with the number
+// of other pods which have problems.  An empty string is returned when no problems are found.
+func DiagnosePods(pods ...corev1.Pod) string {
+	diagnoses := []string{}
+
+	for i := range pods {
+		if diagnosis := diagnosePod(&pods[i]); diagnosis != "" {
+			diagnoses = append(diagnoses, diagnosis)
+		}
+	}
+
+	// pods may be listed in any order, so the diagnoses are sorted to keep the diagnosis stable
+	sort.Strings(diagnoses)
+
+	switch len(diagnoses) {
+	case 0:
+		return ""
+	case 1:
+		return diagnoses[0]
+	default:
+		return fmt.Sprintf("%s (and %d more pods with problems)", diagnoses[0], len(diagnoses)-1)
+	}
+}
+
+// diagnosePod returns a diagnosis of the first problem found with a pod.
+func diagnosePod(pod *corev1.Pod) string {
+	if pod.Status.Phase == corev1.PodSucceeded {
+		return ""
+	}
+
+	for _, condition := range pod.Status.Conditions {
+		if condition.Type == corev1.PodScheduled &&
+			condition.Status == corev1.ConditionFalse &&
+			condition.Reason == corev1.PodReasonUnschedulable {
+			return fmt.Sprintf("pod %s is unschedulable: %s", pod.Name, condition.Message)
+		}
+	}
+
+	for i := range pod.Status.InitContainerStatuses {
+		if diagnosis := diagnoseContainer(&pod.Status.InitContainerStatuses[i], true); diagnosis != "" {
+			return fmt.Sprintf("init container %s of pod %s %s", pod.Status.InitContainerStatuses[i].Name, pod.Name, diagnosis)
+		}
+	}
+
+	for i := range pod.Status.ContainerStatuses {
+		if diagnosis := diagnoseContainer(&pod.Status.ContainerStatuses[i], false); diagnosis != "" {
+			return fmt.Sprintf("container %s of pod %s %s", pod.Status.ContainerStatuses[i].Name, pod.Name, diagnosis)
+		}
+	}
+
+	return ""
+}
+
+// diagnoseContainer returns a diagnosis of the problem with a container.  Init containers which have
+// terminated unsuccessfully have also failed.
+func diagnoseContainer(container *corev1.ContainerStatus, initContainer bool) string {
+	lastTerminated := container.LastTerminationState.Terminated
+
+	if waiting := container.State.Waiting; waiting != nil && containerWaitingProblems[waiting.Reason] {
+		// the message of a crash loop only repeats the back-off, so the previous termination is described instead
+		if waiting.Reason != crashLoopBackOffReason {
+			return withMessage(fmt.Sprintf("is in %s", waiting.Reason), waiting.Message)
+		}
+
+		switch {
+		case lastTerminated == nil:
+			return fmt.Sprintf("is in %s", waiting.Reason)
+		case lastTerminated.Reason == oomKilledReason:
+			return fmt.Sprintf("is in %s after being OOMKilled", waiting.Reason)
+		default:
+			return fmt.Sprintf("is in %s after exiting with code %d", waiting.Reason, lastTerminated.ExitCode)
+		}
+	}
+
+	if terminated := container.State.Terminated; terminated != nil {
+		if terminated.Reason == oomKilledReason {
+			return "was OOMKilled"
+		}
+
+		if initContainer && terminated.ExitCode != 0 {
+			return withMessage(fmt.Sprintf("failed with exit code %d", terminated.ExitCode), terminated.Reason)
+		}
+	}
+
+	if lastTerminated != nil && lastTerminated.Reason == oomKilledReason {
+		return "was OOMKilled"
+	}
+
+	return ""
+}
+
+// withMessage appends a message to a diagnosis when the message is set.
+func withMessage(diagnosis, message string) string {
+	message = strings.TrimSpace(message)
+	if message == "" {
+		return diagnosis
+	}
+
+	return fmt.Sprintf("%s: %s", diagnosis, message)
+}
@@ -0,0 +1,191 @@
+/*
+	SPDX-License-Identifier: MIT
+*/
+
+package resources_test
+
+import (
+	"context"
+	"testing"
+
+	appsv1 "k8s.io/api/apps/v1"
+	v1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	"github.com/nukleros/operator-builder-tools/pkg/controller/workload"
+	"github.com/nukleros/operator-builder-tools/pkg/resources"
+)
+
+func newDiagnosedPod(name string, status v1.PodStatus) v1.Pod {
+	return v1.Pod{
+		ObjectMeta: metav1.ObjectMeta{Name: name, Namespace: "diagnostics", Labels: map[string]string{"app": "web"}},
+		Status:     status,
+	}
+}
+
+func TestDiagnosePods(t *testing.T) {
+	t.Parallel()
+
+	crashLoop := newDiagnosedPod("crash-loop", v1.PodStatus{
+		Phase: v1.PodRunning,
+		ContainerStatuses: []v1.ContainerStatus{{
+			Name:                 "web",
+			State:                v1.ContainerState{Waiting: &v1.ContainerStateWaiting{Reason: "CrashLoopBackOff", Message: "back-off 5m0s"}},
+			LastTerminationState: v1.ContainerState{Terminated: &v1.ContainerStateTerminated{Reason: "Error", ExitCode: 1}},
+		}},
+	})
+
+	tests := []struct {
+		name string
+		pods []v1.Pod
+		want string
+	}{
+		{
+			name: "pods without problems have no diagnosis",
+			pods: []v1.Pod{
+				newDiagnosedPod("running", v1.PodStatus{
+					Phase:             v1.PodRunning,
+					ContainerStatuses: []v1.ContainerStatus{{Name: "web", Ready: true}},
+				}),
+			},
+			want: "",
+		},
+		{
+			name: "container in crash loop reports its exit code",
+			pods: []v1.Pod{crashLoop},
+			want: "container web of pod crash-loop is in CrashLoopBackOff after exiting with code 1",
+		},
+		{
+			name: "container in crash loop after running out of memory reports OOMKilled",
+			pods: []v1.Pod{
+				newDiagnosedPod("oom", v1.PodStatus{
+					Phase: v1.PodRunning,
+					ContainerStatuses: []v1.ContainerStatus{{
+						Name:                 "web",
+						State:                v1.ContainerState{Waiting: &v1.ContainerStateWaiting{Reason: "CrashLoopBackOff"}},
+						LastTerminationState: v1.ContainerState{Terminated: &v1.ContainerStateTerminated{Reason: "OOMKilled", ExitCode: 137}},
+					}},
+				}),
+			},
+			want: "container web of pod oom is in CrashLoopBackOff after being OOMKilled",
+		},
+		{
+			name: "container which cannot pull its image reports the message",
+			pods: []v1.Pod{
+				newDiagnosedPod("image-pull", v1.PodStatus{
+					Phase: v1.PodPending,
+					ContainerStatuses: []v1.ContainerStatus{{
+						Name: "web",
+						State: v1.ContainerState{Waiting: &v1.ContainerStateWaiting{
+							Reason:  "ImagePullBackOff",
+							Message: `Back-off pulling image "web:missing"`,
+						}},
+					}},
+				}),
+			},
+			want: `container web of pod image-pull is in ImagePullBackOff: Back-off pulling image "web:missing"`,
+		},
+		{
+			name: "unschedulable pod reports the scheduler message",
+			pods: []v1.Pod{
+				newDiagnosedPod("unschedulable", v1.PodStatus{
+					Phase: v1.PodPending,
+					Conditions: []v1.PodCondition{{
+						Type:    v1.PodScheduled,
+						Status:  v1.ConditionFalse,
+						Reason:  v1.PodReasonUnschedulable,
+						Message: "0/3 nodes are available: 3 Insufficient cpu.",
+					}},
+				}),
+			},
+			want: "pod unschedulable is unschedulable: 0/3 nodes are available: 3 Insufficient cpu.",
+		},
+		{
+			name: "failed init container reports its exit code",
+			pods: []v1.Pod{
+				newDiagnosedPod("init", v1.PodStatus{
+					Phase: v1.PodPending,
+					InitContainerStatuses: []v1.ContainerStatus{{
+						Name:  "migrate",
+						State: v1.ContainerState{Terminated: &v1.ContainerStateTerminated{Reason: "Error", ExitCode: 2}},
+					}},
+				}),
+			},
+			want: "init container migrate of pod init failed with exit code 2: Error",
+		},
+		{
+			name: "multiple pods with problems report the number of other pods",
+			pods: []v1.Pod{
+				newDiagnosedPod("oom-killed", v1.PodStatus{
+					Phase: v1.PodRunning,
+					ContainerStatuses: []v1.ContainerStatus{{
+						Name:  "web",
+						State: v1.ContainerState{Terminated: &v1.ContainerStateTerminated{Reason: "OOMKilled", ExitCode: 137}},
+					}},
+				}),
+				crashLoop,
+			},
+			want: "container web of pod crash-loop is in CrashLoopBackOff after exiting with code 1 (and 1 more pods with problems)",
+		},
+	}
+
+	for _, tt := range tests {
+		tt := tt
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			if got := resources.DiagnosePods(tt.pods...); got != tt.want {
+				t.Errorf("DiagnosePods() = %q, want %q", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestGetReadinessFromReconciler_WithPodDiagnostics(t *testing.T) {
+	t.Parallel()
+
+	var replicas int32 = 1
+
+	deployment := &appsv1.Deployment{
+		TypeMeta:   metav1.TypeMeta{Kind: resources.DeploymentKind, APIVersion: resources.DeploymentVersion},
+		ObjectMeta: metav1.ObjectMeta{Name: "web", Namespace: "diagnostics"},
+		Spec: appsv1.DeploymentSpec{
+			Replicas: &replicas,
+			Selector: &metav1.LabelSelector{MatchLabels: map[string]string{"app": "web"}},
+		},
+	}
+
+	pod := newDiagnosedPod("web-5d8f9c", v1.PodStatus{
+		Phase: v1.PodRunning,
+		ContainerStatuses: []v1.ContainerStatus{{
+			Name:  "web",
+			State: v1.ContainerState{Waiting: &v1.ContainerStateWaiting{Reason: "ErrImagePull"}},
+		}},
+	})
+
+	r := newClientReconciler(deployment, &pod)
+	req := &workload.Request{Context: context.Background()}
+
+	readiness, err := resources.GetReadinessFromReconciler(r, req, deployment)
+	if err != nil {
+		t.Fatalf("GetReadinessFromReconciler() error = %v", err)
+	}
+
+	if readiness.Diagnosis != "" {
+		t.Errorf("GetReadinessFromReconciler() diagnosis = %q, want no diagnosis without the option", readiness.Diagnosis)
+	}
+
+	readiness, err = resources.GetReadinessFromReconciler(r, req, deployment, resources.ReadyOptionWithPodDiagnostics)
+	if err != nil {
+		t.Fatalf("GetReadinessFromReconciler() error = %v", err)
+	}
+
+	want := "container web of pod web-5d8f9c is in ErrImagePull"
+	if readiness.Diagnosis != want {
+		t.Errorf("GetReadinessFromReconciler() diagnosis = %q, want %q", readiness.Diagnosis, want)
+	}
+
+	if readiness.Message != "0 out of 1 new replicas have been updated; "+want {
+		t.Errorf("GetReadinessFromReconciler() message = %q", readiness.Message)
+	}
+}
@@ -30,12 +30,25 @@ func NewEndpointsResource(object client.Object) (*EndpointsResource, error) {
 	return &EndpointsResource{Object: *endpoints}, nil
 }
 
-// IsReady performs the logic to determine if an Endpoints resource is ready.
+// IsReady performs the logic to determine if an Endpoints resource is ready.  An Endpoints resource is
+// ready once it has at least one ready address.
 func (endpoints *EndpointsResource) IsReady() (bool, error) {
 	// if we have a name that is empty, we know we did not find the object
 	if endpoints.Object.Name == "" {
 		return false, nil
 	}
 
-	return len(endpoints.Object.Subsets) > 0, nil
+	return endpoints.ReadyAddresses() > 0, nil
+}
+
+// ReadyAddresses returns the number of ready addresses of an Endpoints resource.  Addresses which are
+// listed as not ready are not counted.
+func (endpoints *EndpointsResource) ReadyAddresses() int {
+	var ready int
+
+	for _, subset := range endpoints.Object.Subsets {
+		ready += len(subset.Addresses)
+	}
+
+	return ready
 }
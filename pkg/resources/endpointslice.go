@@ -0,0 +1,124 @@
+/*
+	SPDX-License-Identifier: MIT
+*/
+
+package resources
+
+import (
+	"fmt"
+	"strconv"
+	"strings"
+
+	discoveryv1 "k8s.io/api/discovery/v1"
+	"k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/apimachinery/pkg/api/meta"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	"github.com/nukleros/operator-builder-tools/pkg/controller/workload"
+)
+
+const (
+	EndpointSliceKind    = "EndpointSlice"
+	EndpointSliceVersion = "discovery.k8s.io/v1"
+)
+
+// MinReadyEndpointsAnnotation may be set on a resource whose readiness depends on the endpoints of a service,
+// such as a webhook configuration or a CRD with a conversion webhook, to require a minimum number of ready
+// endpoints for each service.  Defaults to 1.
+const MinReadyEndpointsAnnotation = "operator-builder.nukleros.io/min-ready-endpoints"
+
+// defaultMinReadyEndpoints is the number of ready endpoints required when the MinReadyEndpointsAnnotation
+// is not set.
+const defaultMinReadyEndpoints = 1
+
+// ReadyEndpoints returns the number of ready endpoints of a service.  The endpoints are read from the
+// EndpointSlices of the service.  Endpoints without a ready condition are considered ready, as defined
+// by the EndpointSlice API.  On clusters which do not serve EndpointSlices, or when the reconciler is not
+// permitted to list them, the ready addresses of the Endpoints resource of the service are used instead.
+// EndpointSlices are read directly from the API server rather than from the cache so that a controller
+// which is not permitted to list them falls back to Endpoints.  Reading EndpointSlices requires the
+// following RBAC marker on the controller:
+//
+//	+kubebuilder:rbac:groups=discovery.k8s.io,resources=endpointslices,verbs=list
+func (service *ServiceResource) ReadyEndpoints(r workload.Reconciler, req *workload.Request) (int, error) {
+	slices := &discoveryv1.EndpointSliceList{}
+
+	err := apiReader(r).List(
+		req.Context,
+		slices,
+		client.InNamespace(service.Object.Namespace),
+		client.MatchingLabels{discoveryv1.LabelServiceName: service.Object.Name},
+	)
+	if err != nil {
+		if !meta.IsNoMatchError(err) && !errors.IsForbidden(err) {
+			return 0, fmt.Errorf("unable to list endpointslices for service - %w", err)
+		}
+
+		endpoints, err := service.GetEndpoints(r, req)
+		if err != nil {
+			return 0, err
+		}
+
+		return endpoints.ReadyAddresses(), nil
+	}
+
+	// an endpoint appears in more than one slice for a dual-stack service, or briefly while it moves
+	// between slices, so it is only counted once
+	ready := map[string]bool{}
+
+	for i := range slices.Items {
+		for _, endpoint := range slices.Items[i].Endpoints {
+			if endpoint.Conditions.Ready != nil && !*endpoint.Conditions.Ready {
+				continue
+			}
+
+			ready[endpointKey(&endpoint)] = true
+		}
+	}
+
+	return len(ready), nil
+}
+
+// endpointKey returns the key which identifies an endpoint across slices.  Endpoints are identified by
+// the object they target, such as a pod, and by their addresses when they do not target an object.
+func endpointKey(endpoint *discoveryv1.Endpoint) string {
+	if endpoint.TargetRef != nil && endpoint.TargetRef.UID != "" {
+		return string(endpoint.TargetRef.UID)
+	}
+
+	return strings.Join(endpoint.Addresses, ",")
+}
+
+// serviceReadiness determines if a service which backs another resource has the minimum number of
+// ready endpoints.
+func serviceReadiness(r workload.Reconciler, req *workload.Request, service *ServiceResource, minReady int) (*Readiness, error) {
+	ready, err := service.ReadyEndpoints(r, req)
+	if err != nil {
+		return nil, err
+	}
+
+	message := fmt.Sprintf("service %s/%s has %d/%d ready endpoints", service.Object.Namespace, service.Object.Name, ready, minReady)
+
+	if ready < minReady {
+		return Progressing("ServiceNotReady", message), nil
+	}
+
+	return Ready(message), nil
+}
+
+// minReadyEndpoints returns the minimum number of ready endpoints required by a resource.
+func minReadyEndpoints(object metav1.Object) (int, error) {
+	value, ok := object.GetAnnotations()[MinReadyEndpointsAnnotation]
+	if !ok {
+		return defaultMinReadyEndpoints, nil
+	}
+
+	minReady, err := strconv.Atoi(value)
+	if err != nil || minReady < 0 {
+		return 0, fmt.Errorf("invalid value [%s] for annotation [%s], expected a non-negative integer",
+			value, MinReadyEndpointsAnnotation)
+	}
+
+	return minReady, nil
+}
@@ -0,0 +1,210 @@
+/*
+	SPDX-License-Identifier: MIT
+*/
+
+package resources_test
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"testing"
+
+	v1 "k8s.io/api/core/v1"
+	discoveryv1 "k8s.io/api/discovery/v1"
+	"k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/apimachinery/pkg/api/meta"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	"k8s.io/apimachinery/pkg/types"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/manager"
+
+	"github.com/nukleros/operator-builder-tools/pkg/controller/workload"
+	"github.com/nukleros/operator-builder-tools/pkg/resources"
+)
+
+// newEndpointSlice returns an endpoint slice for a service with an endpoint for each of the ready
+// conditions.  A nil ready condition is represented by omitting the condition.
+func newEndpointSlice(namespace, service string, ready ...interface{}) *discoveryv1.EndpointSlice {
+	slice := &discoveryv1.EndpointSlice{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      fmt.Sprintf("%s-%d", service, len(ready)),
+			Namespace: namespace,
+			Labels:    map[string]string{discoveryv1.LabelServiceName: service},
+		},
+		AddressType: discoveryv1.AddressTypeIPv4,
+	}
+
+	for i, condition := range ready {
+		endpoint := discoveryv1.Endpoint{Addresses: []string{fmt.Sprintf("10.0.0.%d", i+1)}}
+
+		if isReady, ok := condition.(bool); ok {
+			endpoint.Conditions.Ready = &isReady
+		}
+
+		slice.Endpoints = append(slice.Endpoints, endpoint)
+	}
+
+	return slice
+}
+
+// newDualStackEndpointSlices returns the IPv4 and IPv6 endpoint slices of a service with a ready endpoint
+// for each of the named pods.
+func newDualStackEndpointSlices(namespace, service string, pods ...string) []client.Object {
+	slices := []client.Object{}
+
+	for _, addressType := range []discoveryv1.AddressType{discoveryv1.AddressTypeIPv4, discoveryv1.AddressTypeIPv6} {
+		slice := &discoveryv1.EndpointSlice{
+			ObjectMeta: metav1.ObjectMeta{
+				Name:      fmt.Sprintf("%s-%s", service, strings.ToLower(string(addressType))),
+				Namespace: namespace,
+				Labels:    map[string]string{discoveryv1.LabelServiceName: service},
+			},
+			AddressType: addressType,
+		}
+
+		for i, pod := range pods {
+			address := fmt.Sprintf("10.0.0.%d", i+1)
+			if addressType == discoveryv1.AddressTypeIPv6 {
+				address = fmt.Sprintf("fd00::%d", i+1)
+			}
+
+			slice.Endpoints = append(slice.Endpoints, discoveryv1.Endpoint{
+				Addresses: []string{address},
+				TargetRef: &v1.ObjectReference{Kind: "Pod", Name: pod, Namespace: namespace, UID: types.UID(pod + "-uid")},
+			})
+		}
+
+		slices = append(slices, slice)
+	}
+
+	return slices
+}
+
+// legacyReconciler is a reconciler which is unable to list endpoint slices from the API server, such as on
+// a cluster which does not serve them or for a controller which is not permitted to list them.  Listing
+// endpoint slices through its cache fails, as the informer of a forbidden kind would never sync.
+type legacyReconciler struct {
+	*clientReconciler
+
+	err error
+}
+
+func (r *legacyReconciler) GetManager() manager.Manager {
+	return &readerManager{reader: &failingReader{Reader: r.client, err: r.err}}
+}
+
+func (r *legacyReconciler) List(ctx context.Context, list client.ObjectList, opts ...client.ListOption) error {
+	if _, ok := list.(*discoveryv1.EndpointSliceList); ok {
+		return fmt.Errorf("endpointslices listed through the cache")
+	}
+
+	return r.clientReconciler.List(ctx, list, opts...)
+}
+
+// failingReader is a reader which returns an error when listing endpoint slices.
+type failingReader struct {
+	client.Reader
+
+	err error
+}
+
+func (r *failingReader) List(ctx context.Context, list client.ObjectList, opts ...client.ListOption) error {
+	if _, ok := list.(*discoveryv1.EndpointSliceList); ok {
+		return r.err
+	}
+
+	return r.Reader.List(ctx, list, opts...)
+}
+
+func TestServiceResource_ReadyEndpoints(t *testing.T) {
+	t.Parallel()
+
+	endpoints := &v1.Endpoints{
+		ObjectMeta: metav1.ObjectMeta{Name: "service", Namespace: "system"},
+		Subsets: []v1.EndpointSubset{{
+			Addresses:         []v1.EndpointAddress{{IP: "10.0.0.1"}},
+			NotReadyAddresses: []v1.EndpointAddress{{IP: "10.0.0.2"}},
+		}},
+	}
+
+	tests := []struct {
+		name       string
+		reconciler workload.Reconciler
+		want       int
+		wantErr    bool
+	}{
+		{
+			name:       "endpoints with a ready condition are counted",
+			reconciler: newClientReconciler(newEndpointSlice("system", "service", true, false, nil)),
+			want:       2,
+		},
+		{
+			name: "endpoints of other services are not counted",
+			reconciler: newClientReconciler(
+				newEndpointSlice("system", "service", true),
+				newEndpointSlice("system", "other", true, true),
+			),
+			want: 1,
+		},
+		{
+			name: "endpoints in more than one slice are counted once",
+			reconciler: newClientReconciler(
+				newEndpointSlice("system", "service", true),
+				newEndpointSlice("system", "service", true, true),
+			),
+			want: 2,
+		},
+		{
+			name:       "endpoints of a dual-stack service are counted once",
+			reconciler: newClientReconciler(newDualStackEndpointSlices("system", "service", "pod-a", "pod-b")...),
+			want:       2,
+		},
+		{
+			name:       "service without endpoint slices has no ready endpoints",
+			reconciler: newClientReconciler(),
+			want:       0,
+		},
+		{
+			name: "ready addresses of endpoints are used without endpoint slices",
+			reconciler: &legacyReconciler{
+				clientReconciler: newClientReconciler(endpoints),
+				err:              &meta.NoKindMatchError{GroupKind: schema.GroupKind{Group: discoveryv1.GroupName, Kind: resources.EndpointSliceKind}},
+			},
+			want: 1,
+		},
+		{
+			name: "ready addresses of endpoints are used when endpoint slices are forbidden",
+			reconciler: &legacyReconciler{
+				clientReconciler: newClientReconciler(endpoints),
+				err: errors.NewForbidden(
+					schema.GroupResource{Group: discoveryv1.GroupName, Resource: "endpointslices"}, "", fmt.Errorf("rbac"),
+				),
+			},
+			want: 1,
+		},
+	}
+
+	for _, tt := range tests {
+		tt := tt
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			service := &resources.ServiceResource{
+				Object: v1.Service{ObjectMeta: metav1.ObjectMeta{Name: "service", Namespace: "system"}},
+			}
+
+			got, err := service.ReadyEndpoints(tt.reconciler, &workload.Request{Context: context.Background()})
+			if (err != nil) != tt.wantErr {
+				t.Errorf("ServiceResource.ReadyEndpoints() error = %v, wantErr %v", err, tt.wantErr)
+
+				return
+			}
+
+			if got != tt.want {
+				t.Errorf("ServiceResource.ReadyEndpoints() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
@@ -0,0 +1,173 @@
+/*
+	SPDX-License-Identifier: MIT
+*/
+
+package resources
+
+import (
+	"errors"
+	"fmt"
+	"strings"
+	"sync"
+
+	"github.com/google/cel-go/cel"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+)
+
+const (
+	ReadyExpressionAnnotation  = "operator-builder.nukleros.io/ready-expression"
+	FailedExpressionAnnotation = "operator-builder.nukleros.io/failed-expression"
+)
+
+// expressionVariable is the name of the variable which holds the object when evaluating an expression.
+const expressionVariable = "self"
+
+// ErrInvalidExpression is returned when an expression annotation cannot be compiled or does not
+// evaluate to a boolean.
+var ErrInvalidExpression = errors.New("invalid expression")
+
+// expressionCache stores compiled expressions so that each expression is only compiled once.
+//
+//nolint:gochecknoglobals
+var expressionCache = &programCache{programs: map[string]*compiledExpression{}}
+
+// compiledExpression is the outcome of compiling an expression.
+type compiledExpression struct {
+	program cel.Program
+	err     error
+}
+
+// programCache stores compiled expressions keyed by their source.
+type programCache struct {
+	lock     sync.RWMutex
+	env      *cel.Env
+	programs map[string]*compiledExpression
+}
+
+// hasExpressionAnnotations determines if an object has either of the expression annotations set.
+func hasExpressionAnnotations(object client.Object) bool {
+	if object == nil {
+		return false
+	}
+
+	annotations := object.GetAnnotations()
+
+	return annotations[ReadyExpressionAnnotation] != "" || annotations[FailedExpressionAnnotation] != ""
+}
+
+// readinessFromExpressions determines the readiness of an object from its expression annotations.  An
+// object whose failed expression is true has failed.  Otherwise, the object is ready when its ready
+// expression is true, or when it has no ready expression.
+func readinessFromExpressions(object client.Object) (*Readiness, error) {
+	asUnstructured, err := ToUnstructured(object)
+	if err != nil {
+		return nil, err
+	}
+
+	annotations := object.GetAnnotations()
+
+	if expression := annotations[FailedExpressionAnnotation]; expression != "" {
+		failed, err := evaluateExpression(expression, asUnstructured.Object)
+		if err != nil {
+			return nil, fmt.Errorf("unable to evaluate failed expression annotation [%s] - %w", FailedExpressionAnnotation, err)
+		}
+
+		if failed {
+			return Failed("FailedExpressionMatched", fmt.Sprintf("%s is true", expression)), nil
+		}
+	}
+
+	expression := annotations[ReadyExpressionAnnotation]
+	if expression == "" {
+		return Ready("failed expression is false"), nil
+	}
+
+	ready, err := evaluateExpression(expression, asUnstructured.Object)
+	if err != nil {
+		return nil, fmt.Errorf("unable to evaluate ready expression annotation [%s] - %w", ReadyExpressionAnnotation, err)
+	}
+
+	if !ready {
+		return Progressing("ReadyExpressionNotMatched", fmt.Sprintf("%s is false", expression)), nil
+	}
+
+	return Ready(fmt.Sprintf("%s is true", expression)), nil
+}
+
+// evaluateExpression evaluates a CEL expression against an object, which is available to the expression
+// as the self variable.  Expressions which reference a field that the object does not have evaluate to
+// false, so that an object is not ready until its status has been populated.
+func evaluateExpression(expression string, object map[string]interface{}) (bool, error) {
+	program, err := expressionCache.get(expression)
+	if err != nil {
+		return false, err
+	}
+
+	result, _, err := program.Eval(map[string]interface{}{expressionVariable: object})
+	if err != nil {
+		if strings.Contains(err.Error(), "no such key") {
+			return false, nil
+		}
+
+		return false, fmt.Errorf("unable to evaluate expression [%s] - %w", expression, err)
+	}
+
+	value, ok := result.Value().(bool)
+	if !ok {
+		return false, fmt.Errorf("%w; expression [%s] returned %s instead of bool", ErrInvalidExpression, expression, result.Type())
+	}
+
+	return value, nil
+}
+
+// get returns the compiled program for an expression, compiling it if it has not been compiled before.
+func (cache *programCache) get(expression string) (cel.Program, error) {
+	cache.lock.RLock()
+	compiled, ok := cache.programs[expression]
+	cache.lock.RUnlock()
+
+	if ok {
+		return compiled.program, compiled.err
+	}
+
+	cache.lock.Lock()
+	defer cache.lock.Unlock()
+
+	// another caller may have compiled the expression while waiting for the lock
+	if compiled, ok := cache.programs[expression]; ok {
+		return compiled.program, compiled.err
+	}
+
+	program, err := cache.compile(expression)
+	cache.programs[expression] = &compiledExpression{program: program, err: err}
+
+	return program, err
+}
+
+// compile compiles an expression into a program.  It must be called while holding the lock.
+func (cache *programCache) compile(expression string) (cel.Program, error) {
+	if cache.env == nil {
+		env, err := cel.NewEnv(cel.Variable(expressionVariable, cel.DynType))
+		if err != nil {
+			return nil, fmt.Errorf("unable to create expression environment - %w", err)
+		}
+
+		cache.env = env
+	}
+
+	ast, issues := cache.env.Compile(expression)
+	if issues.Err() != nil {
+		return nil, fmt.Errorf("%w; unable to compile expression [%s] - %s", ErrInvalidExpression, expression, issues.Err())
+	}
+
+	if ast.OutputType() != cel.BoolType && ast.OutputType() != cel.DynType {
+		return nil, fmt.Errorf("%w; expression [%s] returns %s instead of bool", ErrInvalidExpression, expression, ast.OutputType())
+	}
+
+	program, err := cache.env.Program(ast)
+	if err != nil {
+		return nil, fmt.Errorf("%w; unable to create program for expression [%s] - %s", ErrInvalidExpression, expression, err)
+	}
+
+	return program, nil
+}
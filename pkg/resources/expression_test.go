@@ -0,0 +1,126 @@
+/*
+	SPDX-License-Identifier: MIT
+*/
+
+package resources_test
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/nukleros/operator-builder-tools/pkg/resources"
+	"github.com/nukleros/operator-builder-tools/pkg/status"
+)
+
+func TestUnknownResource_Readiness_Expressions(t *testing.T) {
+	t.Parallel()
+
+	runningStatus := map[string]interface{}{
+		"replicas": int64(2),
+		"phase":    "Running",
+	}
+
+	tests := []struct {
+		name        string
+		annotations map[string]string
+		status      map[string]interface{}
+		want        status.ResourceState
+		wantErr     error
+	}{
+		{
+			name: "ready expression which is true is ready",
+			annotations: map[string]string{
+				resources.ReadyExpressionAnnotation: "self.status.replicas >= 1 && self.status.phase in ['Running', 'Succeeded']",
+			},
+			status: runningStatus,
+			want:   status.ResourceStateReady,
+		},
+		{
+			name: "ready expression which is false is progressing",
+			annotations: map[string]string{
+				resources.ReadyExpressionAnnotation: "self.status.phase == 'Succeeded'",
+			},
+			status: runningStatus,
+			want:   status.ResourceStateProgressing,
+		},
+		{
+			name: "ready expression referencing a missing field is progressing",
+			annotations: map[string]string{
+				resources.ReadyExpressionAnnotation: "self.status.readyReplicas >= 1",
+			},
+			status: runningStatus,
+			want:   status.ResourceStateProgressing,
+		},
+		{
+			name: "failed expression which is true has failed",
+			annotations: map[string]string{
+				resources.ReadyExpressionAnnotation:  "self.status.phase == 'Succeeded'",
+				resources.FailedExpressionAnnotation: "self.status.phase == 'Running' && self.status.replicas > 1",
+			},
+			status: runningStatus,
+			want:   status.ResourceStateFailed,
+		},
+		{
+			name: "failed expression which is false without a ready expression is ready",
+			annotations: map[string]string{
+				resources.FailedExpressionAnnotation: "self.status.phase == 'Failed'",
+			},
+			status: runningStatus,
+			want:   status.ResourceStateReady,
+		},
+		{
+			name: "expressions take precedence over the ready path annotations",
+			annotations: map[string]string{
+				resources.ReadyExpressionAnnotation: "self.status.phase == 'Succeeded'",
+				resources.ReadyPathAnnotation:       ".status.phase",
+				resources.ReadyValueAnnotation:      "Running",
+			},
+			status: runningStatus,
+			want:   status.ResourceStateProgressing,
+		},
+		{
+			name: "expression which cannot be compiled returns an error",
+			annotations: map[string]string{
+				resources.ReadyExpressionAnnotation: "self.status.phase ==",
+			},
+			status:  runningStatus,
+			wantErr: resources.ErrInvalidExpression,
+		},
+		{
+			name: "expression which does not return a bool returns an error",
+			annotations: map[string]string{
+				resources.ReadyExpressionAnnotation: "self.status.phase",
+			},
+			status:  runningStatus,
+			wantErr: resources.ErrInvalidExpression,
+		},
+	}
+
+	for _, tt := range tests {
+		tt := tt
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			unknown := &resources.UnknownResource{Object: newUnknownObject(1, tt.annotations, tt.status)}
+
+			got, err := unknown.Readiness()
+			if tt.wantErr != nil {
+				if !errors.Is(err, tt.wantErr) {
+					t.Errorf("UnknownResource.Readiness() error = %v, wantErr %v", err, tt.wantErr)
+				}
+
+				return
+			}
+
+			if err != nil {
+				t.Errorf("UnknownResource.Readiness() error = %v", err)
+
+				return
+			}
+
+			if got.State != tt.want {
+				t.Errorf("UnknownResource.Readiness() = %v, want %v", got.State, tt.want)
+			}
+		})
+	}
+}
@@ -0,0 +1,229 @@
+/*
+	SPDX-License-Identifier: MIT
+*/
+
+package resources
+
+import (
+	"fmt"
+	"strings"
+
+	"k8s.io/apimachinery/pkg/api/meta"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	gwv1 "sigs.k8s.io/gateway-api/apis/v1"
+)
+
+const (
+	GatewayKind   = "Gateway"
+	HTTPRouteKind = "HTTPRoute"
+	GRPCRouteKind = "GRPCRoute"
+)
+
+// GatewayResource represents a Gateway API Gateway object.
+type GatewayResource struct {
+	Object gwv1.Gateway
+}
+
+// HTTPRouteResource represents a Gateway API HTTPRoute object.
+type HTTPRouteResource struct {
+	Object gwv1.HTTPRoute
+}
+
+// GRPCRouteResource represents a Gateway API GRPCRoute object.
+type GRPCRouteResource struct {
+	Object gwv1.GRPCRoute
+}
+
+// NewGatewayResource creates and returns a new GatewayResource.
+func NewGatewayResource(object client.Object) (*GatewayResource, error) {
+	gateway := &gwv1.Gateway{}
+
+	if err := ToTyped(gateway, object); err != nil {
+		return nil, err
+	}
+
+	return &GatewayResource{Object: *gateway}, nil
+}
+
+// NewHTTPRouteResource creates and returns a new HTTPRouteResource.
+func NewHTTPRouteResource(object client.Object) (*HTTPRouteResource, error) {
+	route := &gwv1.HTTPRoute{}
+
+	if err := ToTyped(route, object); err != nil {
+		return nil, err
+	}
+
+	return &HTTPRouteResource{Object: *route}, nil
+}
+
+// NewGRPCRouteResource creates and returns a new GRPCRouteResource.
+func NewGRPCRouteResource(object client.Object) (*GRPCRouteResource, error) {
+	route := &gwv1.GRPCRoute{}
+
+	if err := ToTyped(route, object); err != nil {
+		return nil, err
+	}
+
+	return &GRPCRouteResource{Object: *route}, nil
+}
+
+// IsReady checks to see if a Gateway is ready.
+func (gateway *GatewayResource) IsReady() (bool, error) {
+	return readinessIsReady(gateway.Readiness())
+}
+
+// Readiness checks to see if a Gateway is ready along with the reason for it.  A Gateway is ready
+// once its Accepted and Programmed conditions are true for its current generation.
+func (gateway *GatewayResource) Readiness() (*Readiness, error) {
+	// if we have a name that is empty, we know we did not find the object
+	if gateway.Object.Name == "" {
+		return Progressing("NotFound", "gateway not found"), nil
+	}
+
+	for _, conditionType := range []gwv1.GatewayConditionType{gwv1.GatewayConditionAccepted, gwv1.GatewayConditionProgrammed} {
+		readiness := gatewayConditionReadiness(gateway.Object.Status.Conditions, string(conditionType), gateway.Object.Generation)
+		if !readiness.IsReady() {
+			return readiness, nil
+		}
+	}
+
+	return Ready("gateway is accepted and programmed"), nil
+}
+
+// IsReady checks to see if an HTTPRoute is ready.
+func (route *HTTPRouteResource) IsReady() (bool, error) {
+	return readinessIsReady(route.Readiness())
+}
+
+// Readiness checks to see if an HTTPRoute is ready along with the reason for it.  An HTTPRoute is
+// ready once every parent that it references has accepted it.
+func (route *HTTPRouteResource) Readiness() (*Readiness, error) {
+	// if we have a name that is empty, we know we did not find the object
+	if route.Object.Name == "" {
+		return Progressing("NotFound", "httproute not found"), nil
+	}
+
+	return routeReadiness(&route.Object, route.Object.Spec.ParentRefs, route.Object.Status.Parents), nil
+}
+
+// IsReady checks to see if a GRPCRoute is ready.
+func (route *GRPCRouteResource) IsReady() (bool, error) {
+	return readinessIsReady(route.Readiness())
+}
+
+// Readiness checks to see if a GRPCRoute is ready along with the reason for it.  A GRPCRoute is
+// ready once every parent that it references has accepted it.
+func (route *GRPCRouteResource) Readiness() (*Readiness, error) {
+	// if we have a name that is empty, we know we did not find the object
+	if route.Object.Name == "" {
+		return Progressing("NotFound", "grpcroute not found"), nil
+	}
+
+	return routeReadiness(&route.Object, route.Object.Spec.ParentRefs, route.Object.Status.Parents), nil
+}
+
+// gatewayConditionReadiness returns the readiness of a Gateway API object from a single condition.  The
+// condition must be true and must have been set for the current generation of the object.
+func gatewayConditionReadiness(conditions []metav1.Condition, conditionType string, generation int64) *Readiness {
+	condition := meta.FindStatusCondition(conditions, conditionType)
+	if condition == nil {
+		return Progressing(conditionType+"Unknown", fmt.Sprintf("condition %s has not been reported", conditionType))
+	}
+
+	if condition.ObservedGeneration < generation {
+		return Progressing("ObservedGenerationOutdated", fmt.Sprintf("condition %s has not been reported for generation %d",
+			conditionType, generation))
+	}
+
+	return conditionReadiness(condition.Status == metav1.ConditionTrue, condition.Reason, condition.Message)
+}
+
+// routeReadiness determines if a route is ready.  Each parent referenced by the route must report that
+// it has accepted the current generation of the route.
+func routeReadiness(route client.Object, parentRefs []gwv1.ParentReference, parents []gwv1.RouteParentStatus) *Readiness {
+	if len(parentRefs) == 0 {
+		return Ready("route does not reference any parents")
+	}
+
+	pending := []string{}
+
+	for i := range parentRefs {
+		parent := findRouteParentStatus(route, &parentRefs[i], parents)
+		if parent == nil {
+			pending = append(pending, string(parentRefs[i].Name))
+
+			continue
+		}
+
+		readiness := gatewayConditionReadiness(parent.Conditions, string(gwv1.RouteConditionAccepted), route.GetGeneration())
+		if !readiness.IsReady() {
+			return Progressing(readiness.Reason, fmt.Sprintf("parent %s has not accepted the route: %s",
+				parentRefs[i].Name, readiness.Message))
+		}
+	}
+
+	if len(pending) > 0 {
+		return Progressing("ParentsPending", fmt.Sprintf("waiting for parents [%s] to report status", strings.Join(pending, ", ")))
+	}
+
+	return Ready(fmt.Sprintf("accepted by %d/%d parents", len(parentRefs), len(parentRefs)))
+}
+
+// findRouteParentStatus returns the status reported for a parent of a route.
+func findRouteParentStatus(
+	route client.Object,
+	parentRef *gwv1.ParentReference,
+	parents []gwv1.RouteParentStatus,
+) *gwv1.RouteParentStatus {
+	for i := range parents {
+		if parentRefsEqual(route, parentRef, &parents[i].ParentRef) {
+			return &parents[i]
+		}
+	}
+
+	return nil
+}
+
+// parentRefsEqual determines if two parent references of a route refer to the same parent, applying
+// the defaults of each unset field.
+func parentRefsEqual(route client.Object, left, right *gwv1.ParentReference) bool {
+	defaulted := func(value *string, defaultValue string) string {
+		if value == nil {
+			return defaultValue
+		}
+
+		return *value
+	}
+
+	group := func(ref *gwv1.ParentReference) string {
+		return defaulted((*string)(ref.Group), gwv1.GroupName)
+	}
+
+	kind := func(ref *gwv1.ParentReference) string {
+		return defaulted((*string)(ref.Kind), GatewayKind)
+	}
+
+	namespace := func(ref *gwv1.ParentReference) string {
+		return defaulted((*string)(ref.Namespace), route.GetNamespace())
+	}
+
+	section := func(ref *gwv1.ParentReference) string {
+		return defaulted((*string)(ref.SectionName), "")
+	}
+
+	port := func(ref *gwv1.ParentReference) gwv1.PortNumber {
+		if ref.Port == nil {
+			return 0
+		}
+
+		return *ref.Port
+	}
+
+	return left.Name == right.Name &&
+		group(left) == group(right) &&
+		kind(left) == kind(right) &&
+		namespace(left) == namespace(right) &&
+		section(left) == section(right) &&
+		port(left) == port(right)
+}
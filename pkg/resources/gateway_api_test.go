@@ -0,0 +1,223 @@
+/*
+	SPDX-License-Identifier: MIT
+*/
+
+package resources_test
+
+import (
+	"testing"
+
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	gwv1 "sigs.k8s.io/gateway-api/apis/v1"
+
+	"github.com/nukleros/operator-builder-tools/pkg/resources"
+)
+
+func gatewayCondition(conditionType string, status metav1.ConditionStatus, generation int64) metav1.Condition {
+	return metav1.Condition{Type: conditionType, Status: status, ObservedGeneration: generation, Reason: conditionType}
+}
+
+func TestGatewayResource_IsReady(t *testing.T) {
+	t.Parallel()
+
+	type fields struct {
+		parent *gwv1.Gateway
+	}
+
+	tests := []struct {
+		name    string
+		fields  fields
+		want    bool
+		wantErr bool
+	}{
+		{
+			name:    "gateway should be ready",
+			want:    true,
+			wantErr: false,
+			fields: fields{
+				parent: &gwv1.Gateway{
+					ObjectMeta: metav1.ObjectMeta{Name: "ready", Namespace: "ready", Generation: 1},
+					Status: gwv1.GatewayStatus{
+						Conditions: []metav1.Condition{
+							gatewayCondition("Accepted", metav1.ConditionTrue, 1),
+							gatewayCondition("Programmed", metav1.ConditionTrue, 1),
+						},
+					},
+				},
+			},
+		},
+		{
+			name:    "gateway should not be ready (not programmed)",
+			want:    false,
+			wantErr: false,
+			fields: fields{
+				parent: &gwv1.Gateway{
+					ObjectMeta: metav1.ObjectMeta{Name: "not-programmed", Namespace: "not-programmed", Generation: 1},
+					Status: gwv1.GatewayStatus{
+						Conditions: []metav1.Condition{
+							gatewayCondition("Accepted", metav1.ConditionTrue, 1),
+							gatewayCondition("Programmed", metav1.ConditionFalse, 1),
+						},
+					},
+				},
+			},
+		},
+		{
+			name:    "gateway should not be ready (outdated conditions)",
+			want:    false,
+			wantErr: false,
+			fields: fields{
+				parent: &gwv1.Gateway{
+					ObjectMeta: metav1.ObjectMeta{Name: "outdated", Namespace: "outdated", Generation: 2},
+					Status: gwv1.GatewayStatus{
+						Conditions: []metav1.Condition{
+							gatewayCondition("Accepted", metav1.ConditionTrue, 1),
+							gatewayCondition("Programmed", metav1.ConditionTrue, 1),
+						},
+					},
+				},
+			},
+		},
+		{
+			name:    "gateway should not be ready (empty)",
+			want:    false,
+			wantErr: false,
+			fields: fields{
+				parent: &gwv1.Gateway{},
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		tt := tt
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			gateway := &resources.GatewayResource{Object: *tt.fields.parent}
+
+			got, err := gateway.IsReady()
+			if (err != nil) != tt.wantErr {
+				t.Errorf("GatewayResource.IsReady() error = %v, wantErr %v", err, tt.wantErr)
+
+				return
+			}
+
+			if got != tt.want {
+				t.Errorf("GatewayResource.IsReady() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestHTTPRouteResource_IsReady(t *testing.T) {
+	t.Parallel()
+
+	sectionName := gwv1.SectionName("https")
+	otherNamespace := gwv1.Namespace("other")
+
+	parentRefs := []gwv1.ParentReference{
+		{Name: "internal"},
+		{Name: "external", Namespace: &otherNamespace, SectionName: &sectionName},
+	}
+
+	accepted := func(ref gwv1.ParentReference, status metav1.ConditionStatus) gwv1.RouteParentStatus {
+		return gwv1.RouteParentStatus{
+			ParentRef:  ref,
+			Conditions: []metav1.Condition{gatewayCondition("Accepted", status, 1)},
+		}
+	}
+
+	type fields struct {
+		parent *gwv1.HTTPRoute
+	}
+
+	tests := []struct {
+		name    string
+		fields  fields
+		want    bool
+		wantErr bool
+	}{
+		{
+			name:    "httproute should be ready",
+			want:    true,
+			wantErr: false,
+			fields: fields{
+				parent: &gwv1.HTTPRoute{
+					ObjectMeta: metav1.ObjectMeta{Name: "ready", Namespace: "ready", Generation: 1},
+					Spec:       gwv1.HTTPRouteSpec{CommonRouteSpec: gwv1.CommonRouteSpec{ParentRefs: parentRefs}},
+					Status: gwv1.HTTPRouteStatus{
+						RouteStatus: gwv1.RouteStatus{
+							Parents: []gwv1.RouteParentStatus{
+								accepted(parentRefs[1], metav1.ConditionTrue),
+								accepted(parentRefs[0], metav1.ConditionTrue),
+							},
+						},
+					},
+				},
+			},
+		},
+		{
+			name:    "httproute should not be ready (parent not accepted)",
+			want:    false,
+			wantErr: false,
+			fields: fields{
+				parent: &gwv1.HTTPRoute{
+					ObjectMeta: metav1.ObjectMeta{Name: "not-accepted", Namespace: "not-accepted", Generation: 1},
+					Spec:       gwv1.HTTPRouteSpec{CommonRouteSpec: gwv1.CommonRouteSpec{ParentRefs: parentRefs}},
+					Status: gwv1.HTTPRouteStatus{
+						RouteStatus: gwv1.RouteStatus{
+							Parents: []gwv1.RouteParentStatus{
+								accepted(parentRefs[0], metav1.ConditionTrue),
+								accepted(parentRefs[1], metav1.ConditionFalse),
+							},
+						},
+					},
+				},
+			},
+		},
+		{
+			name:    "httproute should not be ready (parent missing)",
+			want:    false,
+			wantErr: false,
+			fields: fields{
+				parent: &gwv1.HTTPRoute{
+					ObjectMeta: metav1.ObjectMeta{Name: "missing", Namespace: "missing", Generation: 1},
+					Spec:       gwv1.HTTPRouteSpec{CommonRouteSpec: gwv1.CommonRouteSpec{ParentRefs: parentRefs}},
+					Status: gwv1.HTTPRouteStatus{
+						RouteStatus: gwv1.RouteStatus{
+							Parents: []gwv1.RouteParentStatus{accepted(parentRefs[0], metav1.ConditionTrue)},
+						},
+					},
+				},
+			},
+		},
+		{
+			name:    "httproute should not be ready (empty)",
+			want:    false,
+			wantErr: false,
+			fields: fields{
+				parent: &gwv1.HTTPRoute{},
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		tt := tt
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			route := &resources.HTTPRouteResource{Object: *tt.fields.parent}
+
+			got, err := route.IsReady()
+			if (err != nil) != tt.wantErr {
+				t.Errorf("HTTPRouteResource.IsReady() error = %v, wantErr %v", err, tt.wantErr)
+
+				return
+			}
+
+			if got != tt.want {
+				t.Errorf("HTTPRouteResource.IsReady() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
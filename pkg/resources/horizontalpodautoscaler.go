@@ -0,0 +1,54 @@
+/*
+	SPDX-License-Identifier: MIT
+*/
+
+package resources
+
+import (
+	autoscalingv2 "k8s.io/api/autoscaling/v2"
+	v1 "k8s.io/api/core/v1"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+)
+
+const (
+	HorizontalPodAutoscalerKind    = "HorizontalPodAutoscaler"
+	HorizontalPodAutoscalerVersion = "autoscaling/v2"
+)
+
+// HorizontalPodAutoscalerResource represents a Kubernetes HorizontalPodAutoscaler object.
+type HorizontalPodAutoscalerResource struct {
+	Object autoscalingv2.HorizontalPodAutoscaler
+}
+
+// NewHorizontalPodAutoscalerResource creates and returns a new HorizontalPodAutoscalerResource.
+func NewHorizontalPodAutoscalerResource(object client.Object) (*HorizontalPodAutoscalerResource, error) {
+	hpa := &autoscalingv2.HorizontalPodAutoscaler{}
+
+	if err := ToTyped(hpa, object); err != nil {
+		return nil, err
+	}
+
+	return &HorizontalPodAutoscalerResource{Object: *hpa}, nil
+}
+
+// IsReady checks to see if a HorizontalPodAutoscaler is ready.
+func (hpa *HorizontalPodAutoscalerResource) IsReady() (bool, error) {
+	return readinessIsReady(hpa.Readiness())
+}
+
+// Readiness checks to see if a HorizontalPodAutoscaler is ready along with the reason for it.  A
+// HorizontalPodAutoscaler is ready once its AbleToScale condition is true.
+func (hpa *HorizontalPodAutoscalerResource) Readiness() (*Readiness, error) {
+	// if we have a name that is empty, we know we did not find the object
+	if hpa.Object.Name == "" {
+		return Progressing("NotFound", "horizontalpodautoscaler not found"), nil
+	}
+
+	for _, condition := range hpa.Object.Status.Conditions {
+		if condition.Type == autoscalingv2.AbleToScale {
+			return conditionReadiness(condition.Status == v1.ConditionTrue, condition.Reason, condition.Message), nil
+		}
+	}
+
+	return Progressing("AbleToScaleUnknown", "horizontalpodautoscaler has not reported whether it is able to scale"), nil
+}
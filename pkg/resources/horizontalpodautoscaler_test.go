@@ -0,0 +1,99 @@
+/*
+	SPDX-License-Identifier: MIT
+*/
+
+package resources_test
+
+import (
+	"testing"
+
+	autoscalingv2 "k8s.io/api/autoscaling/v2"
+	v1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	"github.com/nukleros/operator-builder-tools/pkg/resources"
+)
+
+func TestHorizontalPodAutoscalerResource_IsReady(t *testing.T) {
+	t.Parallel()
+
+	type fields struct {
+		parent *autoscalingv2.HorizontalPodAutoscaler
+	}
+
+	tests := []struct {
+		name    string
+		fields  fields
+		want    bool
+		wantErr bool
+	}{
+		{
+			name:    "horizontalpodautoscaler should be ready",
+			want:    true,
+			wantErr: false,
+			fields: fields{
+				parent: &autoscalingv2.HorizontalPodAutoscaler{
+					ObjectMeta: metav1.ObjectMeta{Name: "ready", Namespace: "ready"},
+					Status: autoscalingv2.HorizontalPodAutoscalerStatus{
+						Conditions: []autoscalingv2.HorizontalPodAutoscalerCondition{
+							{Type: autoscalingv2.AbleToScale, Status: v1.ConditionTrue, Reason: "ReadyForNewScale"},
+						},
+					},
+				},
+			},
+		},
+		{
+			name:    "horizontalpodautoscaler should not be ready (unable to scale)",
+			want:    false,
+			wantErr: false,
+			fields: fields{
+				parent: &autoscalingv2.HorizontalPodAutoscaler{
+					ObjectMeta: metav1.ObjectMeta{Name: "not-ready", Namespace: "not-ready"},
+					Status: autoscalingv2.HorizontalPodAutoscalerStatus{
+						Conditions: []autoscalingv2.HorizontalPodAutoscalerCondition{
+							{Type: autoscalingv2.AbleToScale, Status: v1.ConditionFalse, Reason: "FailedGetScale"},
+						},
+					},
+				},
+			},
+		},
+		{
+			name:    "horizontalpodautoscaler should not be ready (no conditions)",
+			want:    false,
+			wantErr: false,
+			fields: fields{
+				parent: &autoscalingv2.HorizontalPodAutoscaler{
+					ObjectMeta: metav1.ObjectMeta{Name: "no-conditions", Namespace: "no-conditions"},
+				},
+			},
+		},
+		{
+			name:    "horizontalpodautoscaler should not be ready (empty)",
+			want:    false,
+			wantErr: false,
+			fields: fields{
+				parent: &autoscalingv2.HorizontalPodAutoscaler{},
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		tt := tt
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			hpa := &resources.HorizontalPodAutoscalerResource{Object: *tt.fields.parent}
+
+			got, err := hpa.IsReady()
+			if (err != nil) != tt.wantErr {
+				t.Errorf("HorizontalPodAutoscalerResource.IsReady() error = %v, wantErr %v", err, tt.wantErr)
+
+				return
+			}
+
+			if got != tt.want {
+				t.Errorf("HorizontalPodAutoscalerResource.IsReady() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
@@ -0,0 +1,51 @@
+/*
+	SPDX-License-Identifier: MIT
+*/
+
+package resources
+
+import (
+	networkingv1 "k8s.io/api/networking/v1"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+)
+
+const (
+	IngressKind    = "Ingress"
+	IngressVersion = "networking.k8s.io/v1"
+)
+
+// IngressResource represents a Kubernetes Ingress object.
+type IngressResource struct {
+	Object networkingv1.Ingress
+}
+
+// NewIngressResource creates and returns a new IngressResource.
+func NewIngressResource(object client.Object) (*IngressResource, error) {
+	ingress := &networkingv1.Ingress{}
+
+	if err := ToTyped(ingress, object); err != nil {
+		return nil, err
+	}
+
+	return &IngressResource{Object: *ingress}, nil
+}
+
+// IsReady checks to see if an Ingress is ready.
+func (ingress *IngressResource) IsReady() (bool, error) {
+	return readinessIsReady(ingress.Readiness())
+}
+
+// Readiness checks to see if an Ingress is ready along with the reason for it.  An Ingress is ready
+// once its load balancer status has been populated by an ingress controller.
+func (ingress *IngressResource) Readiness() (*Readiness, error) {
+	// if we have a name that is empty, we know we did not find the object
+	if ingress.Object.Name == "" {
+		return Progressing("NotFound", "ingress not found"), nil
+	}
+
+	if len(ingress.Object.Status.LoadBalancer.Ingress) == 0 {
+		return Progressing("LoadBalancerPending", "load balancer status has not been populated"), nil
+	}
+
+	return Ready("load balancer status has been populated"), nil
+}
@@ -0,0 +1,83 @@
+/*
+	SPDX-License-Identifier: MIT
+*/
+
+package resources_test
+
+import (
+	"testing"
+
+	networkingv1 "k8s.io/api/networking/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	"github.com/nukleros/operator-builder-tools/pkg/resources"
+)
+
+func TestIngressResource_IsReady(t *testing.T) {
+	t.Parallel()
+
+	type fields struct {
+		parent *networkingv1.Ingress
+	}
+
+	tests := []struct {
+		name    string
+		fields  fields
+		want    bool
+		wantErr bool
+	}{
+		{
+			name:    "ingress should be ready",
+			want:    true,
+			wantErr: false,
+			fields: fields{
+				parent: &networkingv1.Ingress{
+					ObjectMeta: metav1.ObjectMeta{Name: "ready", Namespace: "ready"},
+					Status: networkingv1.IngressStatus{
+						LoadBalancer: networkingv1.IngressLoadBalancerStatus{
+							Ingress: []networkingv1.IngressLoadBalancerIngress{{IP: "10.0.0.1"}},
+						},
+					},
+				},
+			},
+		},
+		{
+			name:    "ingress should not be ready (load balancer)",
+			want:    false,
+			wantErr: false,
+			fields: fields{
+				parent: &networkingv1.Ingress{
+					ObjectMeta: metav1.ObjectMeta{Name: "not-ready", Namespace: "not-ready"},
+				},
+			},
+		},
+		{
+			name:    "ingress should not be ready (empty)",
+			want:    false,
+			wantErr: false,
+			fields: fields{
+				parent: &networkingv1.Ingress{},
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		tt := tt
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			ingress := &resources.IngressResource{Object: *tt.fields.parent}
+
+			got, err := ingress.IsReady()
+			if (err != nil) != tt.wantErr {
+				t.Errorf("IngressResource.IsReady() error = %v, wantErr %v", err, tt.wantErr)
+
+				return
+			}
+
+			if got != tt.want {
+				t.Errorf("IngressResource.IsReady() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
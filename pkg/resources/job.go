@@ -5,9 +5,14 @@
 package resources
 
 import (
+	"crypto/sha256"
+	"encoding/json"
 	"fmt"
 
 	batchv1 "k8s.io/api/batch/v1"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
 	"sigs.k8s.io/controller-runtime/pkg/client"
 )
 
@@ -16,6 +21,18 @@ const (
 	JobVersion = "batch/v1"
 )
 
+// JobTemplateHashAnnotation is the annotation which records the hash of the pod template that a Job was
+// run with.  A Job whose desired pod template no longer matches the hash is run again.
+const JobTemplateHashAnnotation = "operator-builder.nukleros.io/job-template-hash"
+
+const (
+	// defaultJobBackoffLimit is the backoff limit of a Job which does not specify one.
+	defaultJobBackoffLimit = 6
+
+	// jobTemplateHashLength is the number of characters of the pod template hash which are recorded.
+	jobTemplateHashLength = 16
+)
+
 // JobResource represents a Kubernetes Job object.
 type JobResource struct {
 	Object batchv1.Job
@@ -32,22 +49,149 @@ func NewJobResource(object client.Object) (*JobResource, error) {
 	return &JobResource{Object: *job}, nil
 }
 
+// PodSelector returns the namespace and selector of the pods of a Job.
+func (job *JobResource) PodSelector() (string, *metav1.LabelSelector) {
+	return job.Object.Namespace, job.Object.Spec.Selector
+}
+
 // IsReady checks to see if a Job is ready.
 func (job *JobResource) IsReady() (bool, error) {
+	return readinessIsReady(job.Readiness())
+}
+
+// Readiness checks to see if a Job is ready along with the reason for it.  A Job is ready once it has
+// completed, and has failed once it has a Failed condition or has exceeded its backoff limit.
+func (job *JobResource) Readiness() (*Readiness, error) {
 	// if we have a name that is empty, we know we did not find the object
 	if job.Object.Name == "" {
-		return false, nil
+		return Progressing("NotFound", "job not found"), nil
+	}
+
+	// a job which is being deleted, such as a job which is run again, is not ready until it is created again
+	if job.Object.DeletionTimestamp != nil {
+		return Progressing("Deleting", "job is being deleted"), nil
+	}
+
+	// the conditions of the job are authoritative once they are set by the job controller
+	for _, condition := range job.Object.Status.Conditions {
+		if condition.Status != corev1.ConditionTrue {
+			continue
+		}
+
+		switch condition.Type {
+		case batchv1.JobFailed, batchv1.JobFailureTarget:
+			return Failed(condition.Reason, fmt.Sprintf("Job failed: %s", condition.Reason)), nil
+		case batchv1.JobComplete, batchv1.JobSuccessCriteriaMet:
+			return Ready(job.completionMessage()), nil
+		}
+	}
+
+	if job.Object.Spec.Suspend != nil && *job.Object.Spec.Suspend {
+		return Progressing("JobSuspended", "job is suspended"), nil
+	}
+
+	// report a job which has exceeded its backoff limit as failed before its condition is set.  Indexed
+	// jobs with a backoff limit per index are failed by the job controller per index instead.
+	if job.Object.Spec.BackoffLimitPerIndex == nil && job.Object.Status.Failed > job.backoffLimit() {
+		return Failed(
+			"BackoffLimitExceeded",
+			fmt.Sprintf("Job failed: %d failed pods exceeds backoff limit of %d", job.Object.Status.Failed, job.backoffLimit()),
+		), nil
+	}
+
+	// jobs without completions are complete once any pod succeeds and no pods are active, while jobs with
+	// completions are complete once the number of successful pods reaches the completions
+	complete := job.Object.Status.Succeeded >= job.completions()
+	if job.Object.Spec.Completions == nil {
+		complete = job.Object.Status.Succeeded > 0 && job.Object.Status.Active == 0
 	}
 
-	// return immediately if the job is active or has no completion time
-	if job.Object.Status.Active == 1 || job.Object.Status.CompletionTime == nil {
-		return false, nil
+	if !complete {
+		return Progressing(
+			"JobRunning",
+			fmt.Sprintf("%s, %d active pods", job.completionMessage(), job.Object.Status.Active),
+		), nil
 	}
 
-	// ensure the completion is actually successful
-	if job.Object.Status.Succeeded != 1 {
-		return false, fmt.Errorf("job %s was not successful", job.Object.Name)
+	return Ready(job.completionMessage()), nil
+}
+
+// completions returns the number of successful pods required for the job to complete.
+func (job *JobResource) completions() int32 {
+	if job.Object.Spec.Completions == nil {
+		return 1
+	}
+
+	return *job.Object.Spec.Completions
+}
+
+// backoffLimit returns the number of failed pods which the job tolerates before it has failed.
+func (job *JobResource) backoffLimit() int32 {
+	if job.Object.Spec.BackoffLimit == nil {
+		return defaultJobBackoffLimit
 	}
 
-	return true, nil
+	return *job.Object.Spec.BackoffLimit
+}
+
+// completionMessage returns a message describing the completions of the job.
+func (job *JobResource) completionMessage() string {
+	return fmt.Sprintf("%d/%d completions", job.Object.Status.Succeeded, job.completions())
+}
+
+// SetJobTemplateHash sets the JobTemplateHashAnnotation on a desired Job to the hash of its pod template.
+// Objects which are not Jobs are left unchanged.
+func SetJobTemplateHash(object client.Object) error {
+	if !isJob(object) {
+		return nil
+	}
+
+	asUnstructured, err := ToUnstructured(object)
+	if err != nil {
+		return err
+	}
+
+	template, _, err := unstructured.NestedFieldNoCopy(asUnstructured.Object, "spec", "template")
+	if err != nil {
+		return fmt.Errorf("unable to retrieve pod template of job %s - %w", object.GetName(), err)
+	}
+
+	templateJSON, err := json.Marshal(template)
+	if err != nil {
+		return fmt.Errorf("unable to hash pod template of job %s - %w", object.GetName(), err)
+	}
+
+	annotations := object.GetAnnotations()
+	if annotations == nil {
+		annotations = map[string]string{}
+	}
+
+	annotations[JobTemplateHashAnnotation] = fmt.Sprintf("%x", sha256.Sum256(templateJSON))[:jobTemplateHashLength]
+
+	object.SetAnnotations(annotations)
+
+	return nil
+}
+
+// JobTemplateChanged determines if the pod template of a desired Job differs from the pod template which
+// the Job in the cluster was run with.  Jobs in the cluster without a JobTemplateHashAnnotation were not
+// created with a hash and are not considered changed.
+func JobTemplateChanged(desired, actual client.Object) bool {
+	if !isJob(desired) {
+		return false
+	}
+
+	actualHash := actual.GetAnnotations()[JobTemplateHashAnnotation]
+	if actualHash == "" {
+		return false
+	}
+
+	return desired.GetAnnotations()[JobTemplateHashAnnotation] != actualHash
+}
+
+// isJob determines if an object is a Job.
+func isJob(object client.Object) bool {
+	gvk := object.GetObjectKind().GroupVersionKind()
+
+	return gvk.Group == batchv1.GroupName && gvk.Kind == JobKind
 }
@@ -9,6 +9,8 @@ import (
 	"testing"
 
 	batchv1 "k8s.io/api/batch/v1"
+	v1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"sigs.k8s.io/controller-runtime/pkg/client"
 
 	"github.com/nukleros/operator-builder-tools/pkg/resources"
@@ -56,3 +58,250 @@ func TestNewJobResource(t *testing.T) {
 		})
 	}
 }
+
+func TestJobResource_Readiness(t *testing.T) {
+	t.Parallel()
+
+	two, three, four, five := int32(2), int32(3), int32(4), int32(5)
+	suspend, indexed := true, batchv1.IndexedCompletion
+
+	type fields struct {
+		parent *batchv1.Job
+	}
+
+	tests := []struct {
+		name   string
+		fields fields
+		want   *resources.Readiness
+	}{
+		{
+			name: "job should be ready (complete condition)",
+			fields: fields{
+				parent: &batchv1.Job{
+					ObjectMeta: metav1.ObjectMeta{Name: "complete", Namespace: "complete"},
+					Spec:       batchv1.JobSpec{Completions: &three, Parallelism: &three},
+					Status: batchv1.JobStatus{
+						Succeeded:  3,
+						Conditions: []batchv1.JobCondition{{Type: batchv1.JobComplete, Status: v1.ConditionTrue}},
+					},
+				},
+			},
+			want: resources.Ready("3/3 completions"),
+		},
+		{
+			name: "job should be ready (completions reached)",
+			fields: fields{
+				parent: &batchv1.Job{
+					ObjectMeta: metav1.ObjectMeta{Name: "completions", Namespace: "completions"},
+					Spec:       batchv1.JobSpec{Completions: &two},
+					Status:     batchv1.JobStatus{Succeeded: 2},
+				},
+			},
+			want: resources.Ready("2/2 completions"),
+		},
+		{
+			name: "job should be ready (work queue with a successful pod)",
+			fields: fields{
+				parent: &batchv1.Job{
+					ObjectMeta: metav1.ObjectMeta{Name: "work-queue", Namespace: "work-queue"},
+					Spec:       batchv1.JobSpec{Parallelism: &three},
+					Status:     batchv1.JobStatus{Succeeded: 1},
+				},
+			},
+			want: resources.Ready("1/1 completions"),
+		},
+		{
+			name: "job should not be ready (parallel pods running)",
+			fields: fields{
+				parent: &batchv1.Job{
+					ObjectMeta: metav1.ObjectMeta{Name: "running", Namespace: "running"},
+					Spec: batchv1.JobSpec{
+						Completions:    &five,
+						Parallelism:    &two,
+						CompletionMode: &indexed,
+					},
+					Status: batchv1.JobStatus{Active: 2, Succeeded: 3},
+				},
+			},
+			want: resources.Progressing("JobRunning", "3/5 completions, 2 active pods"),
+		},
+		{
+			name: "job should not be ready (work queue with active pods)",
+			fields: fields{
+				parent: &batchv1.Job{
+					ObjectMeta: metav1.ObjectMeta{Name: "work-queue-active", Namespace: "work-queue-active"},
+					Spec:       batchv1.JobSpec{Parallelism: &three},
+					Status:     batchv1.JobStatus{Active: 2, Succeeded: 1},
+				},
+			},
+			want: resources.Progressing("JobRunning", "1/1 completions, 2 active pods"),
+		},
+		{
+			name: "job should not be ready (retrying within backoff limit)",
+			fields: fields{
+				parent: &batchv1.Job{
+					ObjectMeta: metav1.ObjectMeta{Name: "retrying", Namespace: "retrying"},
+					Spec:       batchv1.JobSpec{BackoffLimit: &two},
+					Status:     batchv1.JobStatus{Active: 1, Failed: 2},
+				},
+			},
+			want: resources.Progressing("JobRunning", "0/1 completions, 1 active pods"),
+		},
+		{
+			name: "job should not be ready (suspended)",
+			fields: fields{
+				parent: &batchv1.Job{
+					ObjectMeta: metav1.ObjectMeta{Name: "suspended", Namespace: "suspended"},
+					Spec:       batchv1.JobSpec{Suspend: &suspend},
+				},
+			},
+			want: resources.Progressing("JobSuspended", "job is suspended"),
+		},
+		{
+			name: "job should not be ready (deleting)",
+			fields: fields{
+				parent: &batchv1.Job{
+					ObjectMeta: metav1.ObjectMeta{Name: "deleting", Namespace: "deleting", DeletionTimestamp: &metav1.Time{}},
+					Status: batchv1.JobStatus{
+						Succeeded:  1,
+						Conditions: []batchv1.JobCondition{{Type: batchv1.JobComplete, Status: v1.ConditionTrue}},
+					},
+				},
+			},
+			want: resources.Progressing("Deleting", "job is being deleted"),
+		},
+		{
+			name: "job should have failed (failed condition)",
+			fields: fields{
+				parent: &batchv1.Job{
+					ObjectMeta: metav1.ObjectMeta{Name: "failed", Namespace: "failed"},
+					Status: batchv1.JobStatus{
+						Failed:     1,
+						Conditions: []batchv1.JobCondition{{Type: batchv1.JobFailed, Status: v1.ConditionTrue, Reason: "DeadlineExceeded"}},
+					},
+				},
+			},
+			want: resources.Failed("DeadlineExceeded", "Job failed: DeadlineExceeded"),
+		},
+		{
+			name: "job should have failed (backoff limit exceeded)",
+			fields: fields{
+				parent: &batchv1.Job{
+					ObjectMeta: metav1.ObjectMeta{Name: "backoff", Namespace: "backoff"},
+					Spec:       batchv1.JobSpec{BackoffLimit: &two},
+					Status:     batchv1.JobStatus{Failed: 3},
+				},
+			},
+			want: resources.Failed("BackoffLimitExceeded", "Job failed: 3 failed pods exceeds backoff limit of 2"),
+		},
+		{
+			name: "job should not have failed (backoff limit per index)",
+			fields: fields{
+				parent: &batchv1.Job{
+					ObjectMeta: metav1.ObjectMeta{Name: "per-index", Namespace: "per-index"},
+					Spec: batchv1.JobSpec{
+						Completions:          &two,
+						CompletionMode:       &indexed,
+						BackoffLimitPerIndex: &four,
+					},
+					Status: batchv1.JobStatus{Active: 2, Failed: 7},
+				},
+			},
+			want: resources.Progressing("JobRunning", "0/2 completions, 2 active pods"),
+		},
+		{
+			name: "job should not be ready (empty)",
+			fields: fields{
+				parent: &batchv1.Job{},
+			},
+			want: resources.Progressing("NotFound", "job not found"),
+		},
+	}
+
+	for _, tt := range tests {
+		tt := tt
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			job := &resources.JobResource{Object: *tt.fields.parent}
+
+			got, err := job.Readiness()
+			if err != nil {
+				t.Errorf("JobResource.Readiness() error = %v", err)
+
+				return
+			}
+
+			if !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("JobResource.Readiness() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestJobTemplateChanged(t *testing.T) {
+	t.Parallel()
+
+	newJob := func(image string) *batchv1.Job {
+		return &batchv1.Job{
+			TypeMeta:   metav1.TypeMeta{Kind: resources.JobKind, APIVersion: resources.JobVersion},
+			ObjectMeta: metav1.ObjectMeta{Name: "job", Namespace: "job"},
+			Spec: batchv1.JobSpec{
+				Template: v1.PodTemplateSpec{
+					Spec: v1.PodSpec{Containers: []v1.Container{{Name: "job", Image: image}}},
+				},
+			},
+		}
+	}
+
+	tests := []struct {
+		name    string
+		desired *batchv1.Job
+		actual  *batchv1.Job
+		hashed  bool
+		want    bool
+	}{
+		{
+			name:    "job with the same template has not changed",
+			desired: newJob("job:v1"),
+			actual:  newJob("job:v1"),
+			hashed:  true,
+			want:    false,
+		},
+		{
+			name:    "job with a different template has changed",
+			desired: newJob("job:v2"),
+			actual:  newJob("job:v1"),
+			hashed:  true,
+			want:    true,
+		},
+		{
+			name:    "job created without a hash has not changed",
+			desired: newJob("job:v2"),
+			actual:  newJob("job:v1"),
+			hashed:  false,
+			want:    false,
+		},
+	}
+
+	for _, tt := range tests {
+		tt := tt
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			if err := resources.SetJobTemplateHash(tt.desired); err != nil {
+				t.Fatalf("SetJobTemplateHash() error = %v", err)
+			}
+
+			if tt.hashed {
+				if err := resources.SetJobTemplateHash(tt.actual); err != nil {
+					t.Fatalf("SetJobTemplateHash() error = %v", err)
+				}
+			}
+
+			if got := resources.JobTemplateChanged(tt.desired, tt.actual); got != tt.want {
+				t.Errorf("JobTemplateChanged() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
@@ -71,5 +71,8 @@ func NamespaceForResourceIsReady(r workload.Reconciler, req *workload.Request, r
 		return false, fmt.Errorf("unable to get namespace, %w", err)
 	}
 
+	// typed objects are not returned with their kind, which is needed to look up their checker
+	namespace.SetGroupVersionKind(gvkFor(NamespaceVersion, NamespaceKind))
+
 	return IsReady(namespace)
 }
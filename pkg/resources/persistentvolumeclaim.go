@@ -0,0 +1,174 @@
+/*
+	SPDX-License-Identifier: MIT
+*/
+
+package resources
+
+import (
+	"fmt"
+
+	v1 "k8s.io/api/core/v1"
+	storagev1 "k8s.io/api/storage/v1"
+	"k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/apimachinery/pkg/types"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	"github.com/nukleros/operator-builder-tools/pkg/controller/workload"
+)
+
+const (
+	PersistentVolumeClaimKind    = "PersistentVolumeClaim"
+	PersistentVolumeClaimVersion = "v1"
+)
+
+const (
+	// selectedNodeAnnotation is set on a claim by the scheduler once a consumer of a claim with a
+	// WaitForFirstConsumer storage class has been scheduled.
+	selectedNodeAnnotation = "volume.kubernetes.io/selected-node"
+
+	// defaultStorageClassAnnotation marks the storage class used by claims which do not request one.
+	defaultStorageClassAnnotation = "storageclass.kubernetes.io/is-default-class"
+)
+
+// PersistentVolumeClaimResource represents a Kubernetes PersistentVolumeClaim object.  The reconciler
+// and request are optional and are used to look up the storage class of the claim.
+type PersistentVolumeClaimResource struct {
+	Object     v1.PersistentVolumeClaim
+	Reconciler workload.Reconciler
+	Request    *workload.Request
+}
+
+// NewPersistentVolumeClaimResource creates and returns a new PersistentVolumeClaimResource.
+func NewPersistentVolumeClaimResource(object client.Object) (*PersistentVolumeClaimResource, error) {
+	claim := &v1.PersistentVolumeClaim{}
+
+	if err := ToTyped(claim, object); err != nil {
+		return nil, err
+	}
+
+	return &PersistentVolumeClaimResource{Object: *claim}, nil
+}
+
+// NewPersistentVolumeClaimResourceFromReconciler creates and returns a new PersistentVolumeClaimResource
+// which is able to look up the storage class of the claim.  Looking up storage classes requires the
+// following RBAC marker on the controller, without which claims are only ready once they are bound:
+//
+//	+kubebuilder:rbac:groups=storage.k8s.io,resources=storageclasses,verbs=get;list
+func NewPersistentVolumeClaimResourceFromReconciler(
+	r workload.Reconciler,
+	req *workload.Request,
+	object client.Object,
+) (*PersistentVolumeClaimResource, error) {
+	claim, err := NewPersistentVolumeClaimResource(object)
+	if err != nil {
+		return nil, err
+	}
+
+	claim.Reconciler = r
+	claim.Request = req
+
+	return claim, nil
+}
+
+// IsReady checks to see if a PersistentVolumeClaim is ready.
+func (claim *PersistentVolumeClaimResource) IsReady() (bool, error) {
+	return readinessIsReady(claim.Readiness())
+}
+
+// Readiness checks to see if a PersistentVolumeClaim is ready along with the reason for it.  A claim is
+// ready once it is bound.  A pending claim whose storage class uses the WaitForFirstConsumer binding mode
+// is also ready until a consumer has been scheduled, as it will not be bound before then.
+func (claim *PersistentVolumeClaimResource) Readiness() (*Readiness, error) {
+	// if we have a name that is empty, we know we did not find the object
+	if claim.Object.Name == "" {
+		return Progressing("NotFound", "persistentvolumeclaim not found"), nil
+	}
+
+	switch claim.Object.Status.Phase {
+	case v1.ClaimBound:
+		return Ready(fmt.Sprintf("bound to volume %s", claim.Object.Spec.VolumeName)), nil
+	case v1.ClaimLost:
+		return Failed("ClaimLost", "the volume bound to the claim has been lost"), nil
+	}
+
+	waiting, err := claim.isWaitingForFirstConsumer()
+	if err != nil {
+		return nil, err
+	}
+
+	if waiting {
+		return Ready("waiting for first consumer to be created before binding"), nil
+	}
+
+	return Progressing("ClaimPending", "claim is not bound"), nil
+}
+
+// isWaitingForFirstConsumer determines if the claim is not bound because its storage class uses the
+// WaitForFirstConsumer binding mode and no consumer has been scheduled yet.  It always returns false
+// when the claim was created without a reconciler.
+func (claim *PersistentVolumeClaimResource) isWaitingForFirstConsumer() (bool, error) {
+	if claim.Reconciler == nil || claim.Request == nil {
+		return false, nil
+	}
+
+	if claim.Object.Annotations[selectedNodeAnnotation] != "" {
+		return false, nil
+	}
+
+	storageClass, err := claim.getStorageClass()
+	if err != nil {
+		return false, err
+	}
+
+	if storageClass == nil || storageClass.VolumeBindingMode == nil {
+		return false, nil
+	}
+
+	return *storageClass.VolumeBindingMode == storagev1.VolumeBindingWaitForFirstConsumer, nil
+}
+
+// getStorageClass retrieves the storage class of the claim, or the default storage class when the claim
+// does not request one.  It returns nil when the storage class does not exist or when the reconciler is
+// not permitted to read storage classes.  Storage classes are read directly from the API server rather
+// than from the cache so that a forbidden lookup returns rather than waiting on the cache to sync.
+func (claim *PersistentVolumeClaimResource) getStorageClass() (*storagev1.StorageClass, error) {
+	if claim.Object.Spec.StorageClassName != nil {
+		if *claim.Object.Spec.StorageClassName == "" {
+			return nil, nil
+		}
+
+		storageClass := &storagev1.StorageClass{}
+
+		err := apiReader(claim.Reconciler).Get(
+			claim.Request.Context,
+			types.NamespacedName{Name: *claim.Object.Spec.StorageClassName},
+			storageClass,
+		)
+		if err != nil {
+			if errors.IsForbidden(err) {
+				return nil, nil
+			}
+
+			return nil, client.IgnoreNotFound(err)
+		}
+
+		return storageClass, nil
+	}
+
+	storageClasses := &storagev1.StorageClassList{}
+	if err := apiReader(claim.Reconciler).List(claim.Request.Context, storageClasses); err != nil {
+		if errors.IsForbidden(err) {
+			return nil, nil
+		}
+
+		return nil, fmt.Errorf("unable to list storage classes, %w", err)
+	}
+
+	for i := range storageClasses.Items {
+		if storageClasses.Items[i].Annotations[defaultStorageClassAnnotation] == "true" {
+			return &storageClasses.Items[i], nil
+		}
+	}
+
+	return nil, nil
+}
@@ -0,0 +1,251 @@
+/*
+	SPDX-License-Identifier: MIT
+*/
+
+package resources_test
+
+import (
+	"context"
+	"testing"
+
+	v1 "k8s.io/api/core/v1"
+	storagev1 "k8s.io/api/storage/v1"
+	"k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	"k8s.io/client-go/kubernetes/scheme"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/client/fake"
+	"sigs.k8s.io/controller-runtime/pkg/manager"
+
+	"github.com/nukleros/operator-builder-tools/pkg/controller/workload"
+	"github.com/nukleros/operator-builder-tools/pkg/resources"
+)
+
+// clientReconciler is a reconciler which only implements the client methods used to look up
+// related resources.
+type clientReconciler struct {
+	workload.Reconciler
+
+	client client.Client
+}
+
+func (r *clientReconciler) Get(ctx context.Context, key client.ObjectKey, obj client.Object, opts ...client.GetOption) error {
+	return r.client.Get(ctx, key, obj, opts...)
+}
+
+func (r *clientReconciler) List(ctx context.Context, list client.ObjectList, opts ...client.ListOption) error {
+	return r.client.List(ctx, list, opts...)
+}
+
+func (r *clientReconciler) GetManager() manager.Manager {
+	return &readerManager{reader: r.client}
+}
+
+// readerManager is a manager which only implements the reader used to read directly from the API server.
+type readerManager struct {
+	manager.Manager
+
+	reader client.Reader
+}
+
+func (m *readerManager) GetAPIReader() client.Reader {
+	return m.reader
+}
+
+func newClientReconciler(objects ...client.Object) *clientReconciler {
+	return &clientReconciler{
+		client: fake.NewClientBuilder().WithScheme(scheme.Scheme).WithObjects(objects...).Build(),
+	}
+}
+
+// forbiddenReconciler is a reconciler which is not permitted to read storage classes from the API server.
+type forbiddenReconciler struct {
+	*clientReconciler
+}
+
+func (r *forbiddenReconciler) GetManager() manager.Manager {
+	return &readerManager{reader: &forbiddenReader{}}
+}
+
+// forbiddenReader is a reader which is not permitted to read any resource.
+type forbiddenReader struct{}
+
+func (r *forbiddenReader) Get(_ context.Context, key client.ObjectKey, _ client.Object, _ ...client.GetOption) error {
+	return errors.NewForbidden(schema.GroupResource{Group: storagev1.GroupName, Resource: "storageclasses"}, key.Name, nil)
+}
+
+func (r *forbiddenReader) List(_ context.Context, _ client.ObjectList, _ ...client.ListOption) error {
+	return errors.NewForbidden(schema.GroupResource{Group: storagev1.GroupName, Resource: "storageclasses"}, "", nil)
+}
+
+func TestPersistentVolumeClaimResource_IsReady(t *testing.T) {
+	t.Parallel()
+
+	waitForFirstConsumer := storagev1.VolumeBindingWaitForFirstConsumer
+	immediate := storagev1.VolumeBindingImmediate
+
+	waitingClassName := "waiting"
+	immediateClassName := "immediate"
+
+	storageClasses := []client.Object{
+		&storagev1.StorageClass{
+			ObjectMeta:        metav1.ObjectMeta{Name: waitingClassName},
+			VolumeBindingMode: &waitForFirstConsumer,
+		},
+		&storagev1.StorageClass{
+			ObjectMeta: metav1.ObjectMeta{
+				Name:        immediateClassName,
+				Annotations: map[string]string{"storageclass.kubernetes.io/is-default-class": "true"},
+			},
+			VolumeBindingMode: &immediate,
+		},
+	}
+
+	type fields struct {
+		parent     *v1.PersistentVolumeClaim
+		reconciler workload.Reconciler
+	}
+
+	tests := []struct {
+		name    string
+		fields  fields
+		want    bool
+		wantErr bool
+	}{
+		{
+			name:    "persistentvolumeclaim should be ready (bound)",
+			want:    true,
+			wantErr: false,
+			fields: fields{
+				parent: &v1.PersistentVolumeClaim{
+					ObjectMeta: metav1.ObjectMeta{Name: "bound", Namespace: "bound"},
+					Status:     v1.PersistentVolumeClaimStatus{Phase: v1.ClaimBound},
+				},
+			},
+		},
+		{
+			name:    "persistentvolumeclaim should be ready (waiting for first consumer)",
+			want:    true,
+			wantErr: false,
+			fields: fields{
+				parent: &v1.PersistentVolumeClaim{
+					ObjectMeta: metav1.ObjectMeta{Name: "waiting", Namespace: "waiting"},
+					Spec:       v1.PersistentVolumeClaimSpec{StorageClassName: &waitingClassName},
+					Status:     v1.PersistentVolumeClaimStatus{Phase: v1.ClaimPending},
+				},
+				reconciler: newClientReconciler(storageClasses...),
+			},
+		},
+		{
+			name:    "persistentvolumeclaim should not be ready (consumer scheduled)",
+			want:    false,
+			wantErr: false,
+			fields: fields{
+				parent: &v1.PersistentVolumeClaim{
+					ObjectMeta: metav1.ObjectMeta{
+						Name:        "scheduled",
+						Namespace:   "scheduled",
+						Annotations: map[string]string{"volume.kubernetes.io/selected-node": "node"},
+					},
+					Spec:   v1.PersistentVolumeClaimSpec{StorageClassName: &waitingClassName},
+					Status: v1.PersistentVolumeClaimStatus{Phase: v1.ClaimPending},
+				},
+				reconciler: newClientReconciler(storageClasses...),
+			},
+		},
+		{
+			name:    "persistentvolumeclaim should not be ready (pending with default storage class)",
+			want:    false,
+			wantErr: false,
+			fields: fields{
+				parent: &v1.PersistentVolumeClaim{
+					ObjectMeta: metav1.ObjectMeta{Name: "pending", Namespace: "pending"},
+					Status:     v1.PersistentVolumeClaimStatus{Phase: v1.ClaimPending},
+				},
+				reconciler: newClientReconciler(storageClasses...),
+			},
+		},
+		{
+			name:    "persistentvolumeclaim should not be ready (pending when storage classes are forbidden)",
+			want:    false,
+			wantErr: false,
+			fields: fields{
+				parent: &v1.PersistentVolumeClaim{
+					ObjectMeta: metav1.ObjectMeta{Name: "pending", Namespace: "pending"},
+					Spec:       v1.PersistentVolumeClaimSpec{StorageClassName: &waitingClassName},
+					Status:     v1.PersistentVolumeClaimStatus{Phase: v1.ClaimPending},
+				},
+				reconciler: &forbiddenReconciler{clientReconciler: newClientReconciler(storageClasses...)},
+			},
+		},
+		{
+			name:    "persistentvolumeclaim should not be ready (pending when default storage class is forbidden)",
+			want:    false,
+			wantErr: false,
+			fields: fields{
+				parent: &v1.PersistentVolumeClaim{
+					ObjectMeta: metav1.ObjectMeta{Name: "pending", Namespace: "pending"},
+					Status:     v1.PersistentVolumeClaimStatus{Phase: v1.ClaimPending},
+				},
+				reconciler: &forbiddenReconciler{clientReconciler: newClientReconciler(storageClasses...)},
+			},
+		},
+		{
+			name:    "persistentvolumeclaim should not be ready (pending without reconciler)",
+			want:    false,
+			wantErr: false,
+			fields: fields{
+				parent: &v1.PersistentVolumeClaim{
+					ObjectMeta: metav1.ObjectMeta{Name: "pending", Namespace: "pending"},
+					Spec:       v1.PersistentVolumeClaimSpec{StorageClassName: &waitingClassName},
+					Status:     v1.PersistentVolumeClaimStatus{Phase: v1.ClaimPending},
+				},
+			},
+		},
+		{
+			name:    "persistentvolumeclaim should not be ready (lost)",
+			want:    false,
+			wantErr: true,
+			fields: fields{
+				parent: &v1.PersistentVolumeClaim{
+					ObjectMeta: metav1.ObjectMeta{Name: "lost", Namespace: "lost"},
+					Status:     v1.PersistentVolumeClaimStatus{Phase: v1.ClaimLost},
+				},
+			},
+		},
+		{
+			name:    "persistentvolumeclaim should not be ready (empty)",
+			want:    false,
+			wantErr: false,
+			fields: fields{
+				parent: &v1.PersistentVolumeClaim{},
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		tt := tt
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			claim := &resources.PersistentVolumeClaimResource{Object: *tt.fields.parent}
+
+			if tt.fields.reconciler != nil {
+				claim.Reconciler = tt.fields.reconciler
+				claim.Request = &workload.Request{Context: context.Background()}
+			}
+
+			got, err := claim.IsReady()
+			if (err != nil) != tt.wantErr {
+				t.Errorf("PersistentVolumeClaimResource.IsReady() error = %v, wantErr %v", err, tt.wantErr)
+
+				return
+			}
+
+			if got != tt.want {
+				t.Errorf("PersistentVolumeClaimResource.IsReady() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
@@ -0,0 +1,73 @@
+/*
+	SPDX-License-Identifier: MIT
+*/
+
+package resources
+
+import (
+	"fmt"
+
+	v1 "k8s.io/api/core/v1"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+)
+
+const (
+	PodKind    = "Pod"
+	PodVersion = "v1"
+)
+
+// PodResource represents a Kubernetes Pod object.
+type PodResource struct {
+	Object v1.Pod
+}
+
+// NewPodResource creates and returns a new PodResource.
+func NewPodResource(object client.Object) (*PodResource, error) {
+	pod := &v1.Pod{}
+
+	if err := ToTyped(pod, object); err != nil {
+		return nil, err
+	}
+
+	return &PodResource{Object: *pod}, nil
+}
+
+// IsReady checks to see if a Pod is ready.
+func (pod *PodResource) IsReady() (bool, error) {
+	return readinessIsReady(pod.Readiness())
+}
+
+// Readiness checks to see if a Pod is ready along with the reason for it.  A Pod is ready once its
+// Ready condition is true or once it has run to completion successfully.
+func (pod *PodResource) Readiness() (*Readiness, error) {
+	// if we have a name that is empty, we know we did not find the object
+	if pod.Object.Name == "" {
+		return Progressing("NotFound", "pod not found"), nil
+	}
+
+	switch pod.Object.Status.Phase {
+	case v1.PodSucceeded:
+		return Ready("pod completed successfully"), nil
+	case v1.PodFailed:
+		reason := pod.Object.Status.Reason
+		if reason == "" {
+			reason = "PodFailed"
+		}
+
+		return Failed(reason, fmt.Sprintf("Pod failed: %s", pod.Object.Status.Message)), nil
+	}
+
+	for _, condition := range pod.Object.Status.Conditions {
+		if condition.Type != v1.PodReady {
+			continue
+		}
+
+		if condition.Status == v1.ConditionTrue {
+			return Ready("pod is ready"), nil
+		}
+
+		return conditionReadiness(false, condition.Reason, condition.Message), nil
+	}
+
+	return Progressing("PodNotReady", fmt.Sprintf("pod is %s", pod.Object.Status.Phase)), nil
+}
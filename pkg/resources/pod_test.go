@@ -0,0 +1,119 @@
+/*
+	SPDX-License-Identifier: MIT
+*/
+
+package resources_test
+
+import (
+	"testing"
+
+	v1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	"github.com/nukleros/operator-builder-tools/pkg/resources"
+)
+
+func TestPodResource_IsReady(t *testing.T) {
+	t.Parallel()
+
+	type fields struct {
+		parent *v1.Pod
+	}
+
+	tests := []struct {
+		name    string
+		fields  fields
+		want    bool
+		wantErr bool
+	}{
+		{
+			name:    "pod should be ready (ready condition)",
+			want:    true,
+			wantErr: false,
+			fields: fields{
+				parent: &v1.Pod{
+					ObjectMeta: metav1.ObjectMeta{Name: "ready", Namespace: "ready"},
+					Status: v1.PodStatus{
+						Phase:      v1.PodRunning,
+						Conditions: []v1.PodCondition{{Type: v1.PodReady, Status: v1.ConditionTrue}},
+					},
+				},
+			},
+		},
+		{
+			name:    "pod should be ready (succeeded)",
+			want:    true,
+			wantErr: false,
+			fields: fields{
+				parent: &v1.Pod{
+					ObjectMeta: metav1.ObjectMeta{Name: "succeeded", Namespace: "succeeded"},
+					Status:     v1.PodStatus{Phase: v1.PodSucceeded},
+				},
+			},
+		},
+		{
+			name:    "pod should not be ready (ready condition)",
+			want:    false,
+			wantErr: false,
+			fields: fields{
+				parent: &v1.Pod{
+					ObjectMeta: metav1.ObjectMeta{Name: "not-ready", Namespace: "not-ready"},
+					Status: v1.PodStatus{
+						Phase:      v1.PodRunning,
+						Conditions: []v1.PodCondition{{Type: v1.PodReady, Status: v1.ConditionFalse, Reason: "ContainersNotReady"}},
+					},
+				},
+			},
+		},
+		{
+			name:    "pod should not be ready (pending)",
+			want:    false,
+			wantErr: false,
+			fields: fields{
+				parent: &v1.Pod{
+					ObjectMeta: metav1.ObjectMeta{Name: "pending", Namespace: "pending"},
+					Status:     v1.PodStatus{Phase: v1.PodPending},
+				},
+			},
+		},
+		{
+			name:    "pod should not be ready (failed)",
+			want:    false,
+			wantErr: true,
+			fields: fields{
+				parent: &v1.Pod{
+					ObjectMeta: metav1.ObjectMeta{Name: "failed", Namespace: "failed"},
+					Status:     v1.PodStatus{Phase: v1.PodFailed, Reason: "Evicted"},
+				},
+			},
+		},
+		{
+			name:    "pod should not be ready (empty)",
+			want:    false,
+			wantErr: false,
+			fields: fields{
+				parent: &v1.Pod{},
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		tt := tt
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			pod := &resources.PodResource{Object: *tt.fields.parent}
+
+			got, err := pod.IsReady()
+			if (err != nil) != tt.wantErr {
+				t.Errorf("PodResource.IsReady() error = %v, wantErr %v", err, tt.wantErr)
+
+				return
+			}
+
+			if got != tt.want {
+				t.Errorf("PodResource.IsReady() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
@@ -0,0 +1,51 @@
+/*
+	SPDX-License-Identifier: MIT
+*/
+
+package resources
+
+import (
+	policyv1 "k8s.io/api/policy/v1"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+)
+
+const (
+	PodDisruptionBudgetKind    = "PodDisruptionBudget"
+	PodDisruptionBudgetVersion = "policy/v1"
+)
+
+// PodDisruptionBudgetResource represents a Kubernetes PodDisruptionBudget object.
+type PodDisruptionBudgetResource struct {
+	Object policyv1.PodDisruptionBudget
+}
+
+// NewPodDisruptionBudgetResource creates and returns a new PodDisruptionBudgetResource.
+func NewPodDisruptionBudgetResource(object client.Object) (*PodDisruptionBudgetResource, error) {
+	pdb := &policyv1.PodDisruptionBudget{}
+
+	if err := ToTyped(pdb, object); err != nil {
+		return nil, err
+	}
+
+	return &PodDisruptionBudgetResource{Object: *pdb}, nil
+}
+
+// IsReady checks to see if a PodDisruptionBudget is ready.
+func (pdb *PodDisruptionBudgetResource) IsReady() (bool, error) {
+	return readinessIsReady(pdb.Readiness())
+}
+
+// Readiness checks to see if a PodDisruptionBudget is ready along with the reason for it.  A
+// PodDisruptionBudget is ready once the disruption controller has observed its current spec.
+func (pdb *PodDisruptionBudgetResource) Readiness() (*Readiness, error) {
+	// if we have a name that is empty, we know we did not find the object
+	if pdb.Object.Name == "" {
+		return Progressing("NotFound", "poddisruptionbudget not found"), nil
+	}
+
+	if pdb.Object.Status.ObservedGeneration < pdb.Object.Generation {
+		return Progressing("ObservedGenerationOutdated", "poddisruptionbudget spec has not been observed"), nil
+	}
+
+	return Ready("poddisruptionbudget spec has been observed"), nil
+}
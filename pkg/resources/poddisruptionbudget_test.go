@@ -0,0 +1,80 @@
+/*
+	SPDX-License-Identifier: MIT
+*/
+
+package resources_test
+
+import (
+	"testing"
+
+	policyv1 "k8s.io/api/policy/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	"github.com/nukleros/operator-builder-tools/pkg/resources"
+)
+
+func TestPodDisruptionBudgetResource_IsReady(t *testing.T) {
+	t.Parallel()
+
+	type fields struct {
+		parent *policyv1.PodDisruptionBudget
+	}
+
+	tests := []struct {
+		name    string
+		fields  fields
+		want    bool
+		wantErr bool
+	}{
+		{
+			name:    "poddisruptionbudget should be ready",
+			want:    true,
+			wantErr: false,
+			fields: fields{
+				parent: &policyv1.PodDisruptionBudget{
+					ObjectMeta: metav1.ObjectMeta{Name: "ready", Namespace: "ready", Generation: 2},
+					Status:     policyv1.PodDisruptionBudgetStatus{ObservedGeneration: 2},
+				},
+			},
+		},
+		{
+			name:    "poddisruptionbudget should not be ready (observed generation)",
+			want:    false,
+			wantErr: false,
+			fields: fields{
+				parent: &policyv1.PodDisruptionBudget{
+					ObjectMeta: metav1.ObjectMeta{Name: "not-observed", Namespace: "not-observed", Generation: 2},
+					Status:     policyv1.PodDisruptionBudgetStatus{ObservedGeneration: 1},
+				},
+			},
+		},
+		{
+			name:    "poddisruptionbudget should not be ready (empty)",
+			want:    false,
+			wantErr: false,
+			fields: fields{
+				parent: &policyv1.PodDisruptionBudget{},
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		tt := tt
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			pdb := &resources.PodDisruptionBudgetResource{Object: *tt.fields.parent}
+
+			got, err := pdb.IsReady()
+			if (err != nil) != tt.wantErr {
+				t.Errorf("PodDisruptionBudgetResource.IsReady() error = %v, wantErr %v", err, tt.wantErr)
+
+				return
+			}
+
+			if got != tt.want {
+				t.Errorf("PodDisruptionBudgetResource.IsReady() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
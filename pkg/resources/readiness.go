@@ -0,0 +1,144 @@
+/*
+	SPDX-License-Identifier: MIT
+*/
+
+package resources
+
+import (
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/nukleros/operator-builder-tools/pkg/status"
+)
+
+// reasons used for the readiness of resources which do not report their own reason.
+const (
+	ReadinessReasonReady    = "Ready"
+	ReadinessReasonNotReady = "NotReady"
+)
+
+// ErrResourceFailed is returned when a resource has failed and will not become ready without intervention.
+var ErrResourceFailed = errors.New("resource has failed")
+
+// Readiness is the result of determining if a resource is ready.
+type Readiness struct {
+	// State defines whether the resource is ready, progressing towards being ready or has failed.
+	State status.ResourceState
+
+	// Reason defines a machine-readable reason for the state, such as BackoffLimitExceeded.
+	Reason string
+
+	// Message defines a human-readable message for the state, such as "2/3 replicas ready".  It includes
+	// the diagnosis when the resource has been diagnosed.
+	Message string
+
+	// Diagnosis defines a human-readable diagnosis of a problem with a resource, such as a container of
+	// one of its pods which is in CrashLoopBackOff or a certificate whose renewal has failed.
+	Diagnosis string
+
+	// RequeueAfter defines when the readiness of a resource should be checked again, such as when a
+	// certificate is due for renewal.  A zero value does not request that the readiness is checked again.
+	RequeueAfter time.Duration
+}
+
+// Ready returns the readiness of a resource which is ready.
+func Ready(message string) *Readiness {
+	return &Readiness{State: status.ResourceStateReady, Reason: ReadinessReasonReady, Message: message}
+}
+
+// Progressing returns the readiness of a resource which is not yet ready.
+func Progressing(reason, message string) *Readiness {
+	return &Readiness{State: status.ResourceStateProgressing, Reason: reason, Message: message}
+}
+
+// Failed returns the readiness of a resource which has failed.
+func Failed(reason, message string) *Readiness {
+	return &Readiness{State: status.ResourceStateFailed, Reason: reason, Message: message}
+}
+
+// IsReady returns whether the resource is ready.
+func (readiness *Readiness) IsReady() bool {
+	return readiness.State == status.ResourceStateReady
+}
+
+// IsFailed returns whether the resource has failed.
+func (readiness *Readiness) IsFailed() bool {
+	return readiness.State == status.ResourceStateFailed
+}
+
+// Err returns an ErrResourceFailed error describing the failure if the resource has failed.
+func (readiness *Readiness) Err() error {
+	if !readiness.IsFailed() {
+		return nil
+	}
+
+	return fmt.Errorf("%w; %s", ErrResourceFailed, readiness)
+}
+
+// Diagnose adds a diagnosis of a problem with the resource to the readiness.  An empty diagnosis leaves
+// the readiness unchanged.
+func (readiness *Readiness) Diagnose(diagnosis string) {
+	if diagnosis == "" {
+		return
+	}
+
+	readiness.Diagnosis = diagnosis
+	readiness.Message = joinMessages(readiness.Message, diagnosis)
+}
+
+// String returns a human-readable description of the readiness.
+func (readiness *Readiness) String() string {
+	if readiness.Message == "" {
+		return readiness.Reason
+	}
+
+	return readiness.Reason + ": " + readiness.Message
+}
+
+// ToResourceCondition returns the resource condition of a resource which has been created with
+// this readiness.
+func (readiness *Readiness) ToResourceCondition() status.ChildResourceCondition {
+	return status.GetReadinessResourceCondition(readiness.State, readiness.Reason, readiness.Message)
+}
+
+// readinessIsReady adapts the readiness of a resource to the result of ResourceChecker.IsReady.  A resource
+// which has failed returns an error.
+func readinessIsReady(readiness *Readiness, err error) (bool, error) {
+	if err != nil {
+		return false, err
+	}
+
+	return readiness.IsReady(), readiness.Err()
+}
+
+// readinessFor returns the readiness of the resource of a checker.  Checkers which do not implement
+// ReadinessChecker report a generic reason.
+func readinessFor(checker ResourceChecker) (*Readiness, error) {
+	if readinessChecker, ok := checker.(ReadinessChecker); ok {
+		return readinessChecker.Readiness()
+	}
+
+	ready, err := checker.IsReady()
+	if err != nil {
+		return nil, err
+	}
+
+	if !ready {
+		return Progressing(ReadinessReasonNotReady, "resource is not ready"), nil
+	}
+
+	return Ready("resource is ready"), nil
+}
+
+// joinMessages joins two messages, either of which may be empty.
+func joinMessages(message, additional string) string {
+	switch {
+	case additional == "":
+		return message
+	case message == "":
+		return additional
+	default:
+		return message + "; " + additional
+	}
+}
@@ -0,0 +1,114 @@
+/*
+	SPDX-License-Identifier: MIT
+*/
+
+package resources_test
+
+import (
+	"errors"
+	"reflect"
+	"testing"
+
+	appsv1 "k8s.io/api/apps/v1"
+	batchv1 "k8s.io/api/batch/v1"
+	v1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	"github.com/nukleros/operator-builder-tools/pkg/resources"
+	"github.com/nukleros/operator-builder-tools/pkg/status"
+)
+
+func TestGetReadiness(t *testing.T) {
+	t.Parallel()
+
+	var deploymentReplicas int32 = 3
+
+	tests := []struct {
+		name    string
+		object  client.Object
+		want    *resources.Readiness
+		wantErr bool
+	}{
+		{
+			name: "deployment with a rollout in progress reports the replicas which are updated",
+			object: &appsv1.Deployment{
+				TypeMeta:   metav1.TypeMeta{Kind: resources.DeploymentKind, APIVersion: resources.DeploymentVersion},
+				ObjectMeta: metav1.ObjectMeta{Name: "deployment"},
+				Spec:       appsv1.DeploymentSpec{Replicas: &deploymentReplicas},
+				Status:     appsv1.DeploymentStatus{Replicas: 3, ReadyReplicas: 3, UpdatedReplicas: 2},
+			},
+			want: resources.Progressing("ReplicasNotUpdated", "2 out of 3 new replicas have been updated"),
+		},
+		{
+			name: "failed job reports the reason for the failure",
+			object: &batchv1.Job{
+				TypeMeta:   metav1.TypeMeta{Kind: resources.JobKind, APIVersion: resources.JobVersion},
+				ObjectMeta: metav1.ObjectMeta{Name: "job"},
+				Status: batchv1.JobStatus{
+					Conditions: []batchv1.JobCondition{
+						{Type: batchv1.JobFailed, Status: v1.ConditionTrue, Reason: "BackoffLimitExceeded"},
+					},
+				},
+			},
+			want: resources.Failed("BackoffLimitExceeded", "Job failed: BackoffLimitExceeded"),
+		},
+		{
+			name: "checker without reasons reports a generic reason",
+			object: &v1.Namespace{
+				TypeMeta:   metav1.TypeMeta{Kind: resources.NamespaceKind, APIVersion: resources.NamespaceVersion},
+				ObjectMeta: metav1.ObjectMeta{Name: "namespace"},
+				Status:     v1.NamespaceStatus{Phase: v1.NamespaceTerminating},
+			},
+			want: resources.Progressing(resources.ReadinessReasonNotReady, "resource is not ready"),
+		},
+		{
+			name: "unknown resource is ready",
+			object: &unstructured.Unstructured{
+				Object: map[string]interface{}{
+					"apiVersion": "example.io/v1",
+					"kind":       "Widget",
+					"metadata":   map[string]interface{}{"name": "widget"},
+				},
+			},
+			want: resources.Ready("resource exists"),
+		},
+	}
+
+	for _, tt := range tests {
+		tt := tt
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			got, err := resources.GetReadiness(tt.object)
+			if (err != nil) != tt.wantErr {
+				t.Errorf("GetReadiness() error = %v, wantErr %v", err, tt.wantErr)
+
+				return
+			}
+
+			if !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("GetReadiness() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestReadiness_ToResourceCondition(t *testing.T) {
+	t.Parallel()
+
+	readiness := resources.Failed("BackoffLimitExceeded", "Job failed: BackoffLimitExceeded")
+
+	condition := readiness.ToResourceCondition()
+	if !condition.Created ||
+		condition.State != status.ResourceStateFailed ||
+		condition.Reason != "BackoffLimitExceeded" ||
+		condition.Message != "Job failed: BackoffLimitExceeded" {
+		t.Errorf("Readiness.ToResourceCondition() = %+v", condition)
+	}
+
+	if err := readiness.Err(); !errors.Is(err, resources.ErrResourceFailed) {
+		t.Errorf("Readiness.Err() = %v, want %v", err, resources.ErrResourceFailed)
+	}
+}
@@ -0,0 +1,212 @@
+/*
+	SPDX-License-Identifier: MIT
+*/
+
+package resources
+
+import (
+	"sync"
+
+	cmacmev1 "github.com/cert-manager/cert-manager/pkg/apis/acme/v1"
+	cmv1 "github.com/cert-manager/cert-manager/pkg/apis/certmanager/v1"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	gwv1 "sigs.k8s.io/gateway-api/apis/v1"
+
+	"github.com/nukleros/operator-builder-tools/pkg/controller/workload"
+)
+
+// AnyVersion may be used as the version of a GroupVersionKind when registering a checker to
+// register the checker for all versions of a group and kind.
+const AnyVersion = "*"
+
+// CheckerFactory creates a ResourceChecker for an object which has already been retrieved
+// from the cluster.
+type CheckerFactory func(object client.Object) (ResourceChecker, error)
+
+// ReconcilerCheckerFactory creates a ResourceChecker for an object which has already been retrieved
+// from the cluster.  It is provided the reconciler and request so that the checker is able to look up
+// related resources in the cluster.
+type ReconcilerCheckerFactory func(r workload.Reconciler, req *workload.Request, object client.Object) (ResourceChecker, error)
+
+// checkerRegistration stores the checker factories for a single kind of resource.
+type checkerRegistration struct {
+	factory           CheckerFactory
+	reconcilerFactory ReconcilerCheckerFactory
+}
+
+// checkerRegistry stores the checker factories used to determine if a resource is ready, keyed
+// by GroupVersionKind.
+type checkerRegistry struct {
+	lock          sync.RWMutex
+	registrations map[schema.GroupVersionKind]*checkerRegistration
+}
+
+// defaultCheckerRegistry is the registry used to determine if a resource is ready.
+//
+//nolint:gochecknoglobals
+var defaultCheckerRegistry = newCheckerRegistry()
+
+// RegisterChecker registers a checker factory for a GroupVersionKind, replacing any checker which was
+// previously registered for the GroupVersionKind, including built-in checkers.  Use AnyVersion as the
+// version to register the checker for all versions of a group and kind.  A checker registered for an
+// exact version takes precedence over a checker registered for AnyVersion.
+func RegisterChecker(gvk schema.GroupVersionKind, factory CheckerFactory) {
+	defaultCheckerRegistry.registerChecker(gvk, factory)
+}
+
+// RegisterReconcilerChecker registers a checker factory which requires a reconciler for a GroupVersionKind.  It
+// follows the same rules as RegisterChecker.  Because the checker requires a reconciler, resources of this
+// kind are treated as unknown resources when readiness is checked without a reconciler.
+func RegisterReconcilerChecker(gvk schema.GroupVersionKind, factory ReconcilerCheckerFactory) {
+	defaultCheckerRegistry.registerReconcilerChecker(gvk, factory)
+}
+
+// newCheckerRegistry creates a new checker registry with the built-in checkers registered.
+func newCheckerRegistry() *checkerRegistry {
+	registry := &checkerRegistry{
+		registrations: map[schema.GroupVersionKind]*checkerRegistration{},
+	}
+
+	registerBuiltinCheckers(registry)
+
+	return registry
+}
+
+// registerBuiltinCheckers registers the checkers for the resources which are known to this library.
+func registerBuiltinCheckers(registry *checkerRegistry) {
+	registry.registerChecker(gvkFor(NamespaceVersion, NamespaceKind), checkerFor(NewNamespaceResource))
+	registry.registerChecker(gvkFor(APIServiceVersion, APIServiceKind), checkerFor(NewAPIServiceResource))
+	registry.registerChecker(gvkFor(SecretVersion, SecretKind), checkerFor(NewSecretResource))
+	registry.registerChecker(gvkFor(ConfigMapVersion, ConfigMapKind), checkerFor(NewConfigMapResource))
+	registry.registerChecker(gvkFor(DeploymentVersion, DeploymentKind), checkerFor(NewDeploymentResource))
+	registry.registerChecker(gvkFor(DaemonSetVersion, DaemonSetKind), checkerFor(NewDaemonSetResource))
+	registry.registerChecker(gvkFor(StatefulSetVersion, StatefulSetKind), checkerFor(NewStatefulSetResource))
+	registry.registerChecker(gvkFor(JobVersion, JobKind), checkerFor(NewJobResource))
+	registry.registerChecker(gvkFor(ServiceVersion, ServiceKind), checkerFor(NewServiceResource))
+	registry.registerChecker(gvkFor(EndpointsVersion, EndpointsKind), checkerFor(NewEndpointsResource))
+	registry.registerChecker(gvkFor(IngressVersion, IngressKind), checkerFor(NewIngressResource))
+	registry.registerChecker(gvkFor(PodVersion, PodKind), checkerFor(NewPodResource))
+	registry.registerChecker(gvkFor(ReplicaSetVersion, ReplicaSetKind), checkerFor(NewReplicaSetResource))
+	registry.registerChecker(gvkFor(PodDisruptionBudgetVersion, PodDisruptionBudgetKind), checkerFor(NewPodDisruptionBudgetResource))
+	registry.registerChecker(
+		gvkFor(HorizontalPodAutoscalerVersion, HorizontalPodAutoscalerKind),
+		checkerFor(NewHorizontalPodAutoscalerResource),
+	)
+
+	// custom resource definitions look up the endpoints of their conversion webhook when a reconciler is available
+	registry.register(gvkFor(CustomResourceDefinitionVersion, CustomResourceDefinitionKind), &checkerRegistration{
+		factory:           checkerFor(NewCRDResource),
+		reconcilerFactory: reconcilerCheckerFor(NewCRDResourceFromReconciler),
+	})
+
+	// persistent volume claims look up their storage class when a reconciler is available
+	registry.register(gvkFor(PersistentVolumeClaimVersion, PersistentVolumeClaimKind), &checkerRegistration{
+		factory:           checkerFor(NewPersistentVolumeClaimResource),
+		reconcilerFactory: reconcilerCheckerFor(NewPersistentVolumeClaimResourceFromReconciler),
+	})
+
+	// cert-manager
+	registry.registerChecker(cmv1.SchemeGroupVersion.WithKind(IssuerKind), checkerFor(NewIssuerResource))
+	registry.registerChecker(cmv1.SchemeGroupVersion.WithKind(ClusterIssuerKind), checkerFor(NewClusterIssuerResource))
+	registry.registerChecker(cmv1.SchemeGroupVersion.WithKind(CertificateKind), checkerFor(NewCertificateResource))
+	registry.registerChecker(cmv1.SchemeGroupVersion.WithKind(CertificateRequestKind), checkerFor(NewCertificateRequestResource))
+	registry.registerChecker(cmacmev1.SchemeGroupVersion.WithKind(OrderKind), checkerFor(NewOrderResource))
+	registry.registerChecker(cmacmev1.SchemeGroupVersion.WithKind(ChallengeKind), checkerFor(NewChallengeResource))
+
+	// gateway api
+	registry.registerChecker(gwv1.SchemeGroupVersion.WithKind(GatewayKind), checkerFor(NewGatewayResource))
+	registry.registerChecker(gwv1.SchemeGroupVersion.WithKind(HTTPRouteKind), checkerFor(NewHTTPRouteResource))
+	registry.registerChecker(gwv1.SchemeGroupVersion.WithKind(GRPCRouteKind), checkerFor(NewGRPCRouteResource))
+
+	// admission webhooks require a reconciler to look up the services which back them
+	registry.registerReconcilerChecker(
+		gvkFor(MutatingWebhookConfigurationVersion, MutatingWebhookConfigurationKind),
+		reconcilerCheckerFor(NewMutatingWebhookConfigurationResource),
+	)
+	registry.registerReconcilerChecker(
+		gvkFor(ValidatingWebhookConfigurationVersion, ValidatingWebhookConfigurationKind),
+		reconcilerCheckerFor(NewValidatingWebhookConfigurationResource),
+	)
+}
+
+// registerChecker stores a checker factory for a GroupVersionKind.
+func (registry *checkerRegistry) registerChecker(gvk schema.GroupVersionKind, factory CheckerFactory) {
+	registry.register(gvk, &checkerRegistration{factory: factory})
+}
+
+// registerReconcilerChecker stores a checker factory which requires a reconciler for a GroupVersionKind.
+func (registry *checkerRegistry) registerReconcilerChecker(gvk schema.GroupVersionKind, factory ReconcilerCheckerFactory) {
+	registry.register(gvk, &checkerRegistration{reconcilerFactory: factory})
+}
+
+// register stores the checker factories for a GroupVersionKind.  A registration may provide both a
+// factory and a factory which requires a reconciler, in which case the latter is preferred when a
+// reconciler is available.
+func (registry *checkerRegistry) register(gvk schema.GroupVersionKind, registration *checkerRegistration) {
+	registry.lock.Lock()
+	defer registry.lock.Unlock()
+
+	registry.registrations[gvk] = registration
+}
+
+// lookup returns the checker registration for a GroupVersionKind.  A registration for the exact
+// GroupVersionKind is preferred over a registration for any version of the group and kind.
+func (registry *checkerRegistry) lookup(gvk schema.GroupVersionKind) *checkerRegistration {
+	registry.lock.RLock()
+	defer registry.lock.RUnlock()
+
+	if registration, ok := registry.registrations[gvk]; ok {
+		return registration
+	}
+
+	return registry.registrations[gvk.GroupKind().WithVersion(AnyVersion)]
+}
+
+// checker returns the checker for an object which has already been retrieved from the cluster.  Objects without
+// a registered checker, or whose checker requires a reconciler, are treated as unknown resources.
+func (registry *checkerRegistry) checker(object client.Object) (ResourceChecker, error) {
+	registration := registry.lookup(object.GetObjectKind().GroupVersionKind())
+	if registration == nil || registration.factory == nil {
+		return NewUnknownResource(object)
+	}
+
+	return registration.factory(object)
+}
+
+// reconcilerChecker returns the checker for an object which has already been retrieved from the cluster,
+// preferring a checker which requires a reconciler.
+func (registry *checkerRegistry) reconcilerChecker(
+	r workload.Reconciler,
+	req *workload.Request,
+	object client.Object,
+) (ResourceChecker, error) {
+	registration := registry.lookup(object.GetObjectKind().GroupVersionKind())
+	if registration == nil || registration.reconcilerFactory == nil {
+		return registry.checker(object)
+	}
+
+	return registration.reconcilerFactory(r, req, object)
+}
+
+// checkerFor adapts the constructor of a built-in resource to a CheckerFactory.
+func checkerFor[T ResourceChecker](constructor func(client.Object) (T, error)) CheckerFactory {
+	return func(object client.Object) (ResourceChecker, error) {
+		return constructor(object)
+	}
+}
+
+// reconcilerCheckerFor adapts the constructor of a built-in resource which requires a reconciler to a
+// ReconcilerCheckerFactory.
+func reconcilerCheckerFor[T ResourceChecker](
+	constructor func(workload.Reconciler, *workload.Request, client.Object) (T, error),
+) ReconcilerCheckerFactory {
+	return func(r workload.Reconciler, req *workload.Request, object client.Object) (ResourceChecker, error) {
+		return constructor(r, req, object)
+	}
+}
+
+// gvkFor returns the GroupVersionKind for an api version and kind.
+func gvkFor(apiVersion, kind string) schema.GroupVersionKind {
+	return schema.FromAPIVersionAndKind(apiVersion, kind)
+}
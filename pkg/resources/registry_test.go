@@ -0,0 +1,90 @@
+/*
+	SPDX-License-Identifier: MIT
+*/
+
+package resources_test
+
+import (
+	"testing"
+
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	"github.com/nukleros/operator-builder-tools/pkg/resources"
+)
+
+// notReadyChecker is a checker which never reports a resource as ready.
+type notReadyChecker struct{}
+
+func (checker *notReadyChecker) IsReady() (bool, error) {
+	return false, nil
+}
+
+func newNotReadyChecker(object client.Object) (resources.ResourceChecker, error) {
+	return &notReadyChecker{}, nil
+}
+
+func TestRegisterChecker(t *testing.T) {
+	t.Parallel()
+
+	resources.RegisterChecker(
+		schema.GroupVersionKind{Group: "exact.example.io", Version: "v1", Kind: "Widget"},
+		newNotReadyChecker,
+	)
+
+	resources.RegisterChecker(
+		schema.GroupVersionKind{Group: "wildcard.example.io", Version: resources.AnyVersion, Kind: "Widget"},
+		newNotReadyChecker,
+	)
+
+	tests := []struct {
+		name    string
+		gvk     schema.GroupVersionKind
+		want    bool
+		wantErr bool
+	}{
+		{
+			name: "registered checker for exact version is used",
+			gvk:  schema.GroupVersionKind{Group: "exact.example.io", Version: "v1", Kind: "Widget"},
+			want: false,
+		},
+		{
+			name: "registered checker for exact version is not used for other versions",
+			gvk:  schema.GroupVersionKind{Group: "exact.example.io", Version: "v2", Kind: "Widget"},
+			want: true,
+		},
+		{
+			name: "registered checker for any version is used",
+			gvk:  schema.GroupVersionKind{Group: "wildcard.example.io", Version: "v1beta1", Kind: "Widget"},
+			want: false,
+		},
+		{
+			name: "built-in checker is not used for a different group with the same kind",
+			gvk:  schema.GroupVersionKind{Group: "example.io", Version: "v1", Kind: "Deployment"},
+			want: true,
+		},
+	}
+
+	for _, tt := range tests {
+		tt := tt
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			object := &unstructured.Unstructured{}
+			object.SetGroupVersionKind(tt.gvk)
+			object.SetName("widget")
+
+			got, err := resources.IsReady(object)
+			if (err != nil) != tt.wantErr {
+				t.Errorf("IsReady() error = %v, wantErr %v", err, tt.wantErr)
+
+				return
+			}
+
+			if got != tt.want {
+				t.Errorf("IsReady() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
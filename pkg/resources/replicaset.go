@@ -0,0 +1,78 @@
+/*
+	SPDX-License-Identifier: MIT
+*/
+
+package resources
+
+import (
+	"fmt"
+
+	appsv1 "k8s.io/api/apps/v1"
+	v1 "k8s.io/api/core/v1"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+)
+
+const (
+	ReplicaSetKind    = "ReplicaSet"
+	ReplicaSetVersion = "apps/v1"
+)
+
+// ReplicaSetResource represents a Kubernetes ReplicaSet object.
+type ReplicaSetResource struct {
+	Object appsv1.ReplicaSet
+}
+
+// NewReplicaSetResource creates and returns a new ReplicaSetResource.
+func NewReplicaSetResource(object client.Object) (*ReplicaSetResource, error) {
+	replicaSet := &appsv1.ReplicaSet{}
+
+	if err := ToTyped(replicaSet, object); err != nil {
+		return nil, err
+	}
+
+	return &ReplicaSetResource{Object: *replicaSet}, nil
+}
+
+// IsReady checks to see if a ReplicaSet is ready.
+func (replicaSet *ReplicaSetResource) IsReady() (bool, error) {
+	return readinessIsReady(replicaSet.Readiness())
+}
+
+// Readiness checks to see if a ReplicaSet is ready along with the reason for it.  A ReplicaSet is ready
+// once its spec has been observed and all of its desired replicas are ready and available.
+func (replicaSet *ReplicaSetResource) Readiness() (*Readiness, error) {
+	// if we have a name that is empty, we know we did not find the object
+	if replicaSet.Object.Name == "" {
+		return Progressing("NotFound", "replicaset not found"), nil
+	}
+
+	// rely on observed generation to give us a proper status
+	if replicaSet.Object.Status.ObservedGeneration < replicaSet.Object.Generation {
+		return Progressing("ObservedGenerationOutdated", "replicaset spec has not been observed"), nil
+	}
+
+	// report failures to create replicas, such as exceeding a quota
+	for _, condition := range replicaSet.Object.Status.Conditions {
+		if condition.Type == appsv1.ReplicaSetReplicaFailure && condition.Status == v1.ConditionTrue {
+			return conditionReadiness(false, condition.Reason, condition.Message), nil
+		}
+	}
+
+	var replicas int32 = 1
+	if replicaSet.Object.Spec.Replicas != nil {
+		replicas = *replicaSet.Object.Spec.Replicas
+	}
+
+	ready := fmt.Sprintf("%d/%d replicas ready", replicaSet.Object.Status.ReadyReplicas, replicas)
+
+	if replicaSet.Object.Status.ReadyReplicas < replicas {
+		return Progressing("ReplicasNotReady", ready), nil
+	}
+
+	if replicaSet.Object.Status.AvailableReplicas < replicas {
+		return Progressing("ReplicasUnavailable", fmt.Sprintf("%d/%d replicas available",
+			replicaSet.Object.Status.AvailableReplicas, replicas)), nil
+	}
+
+	return Ready(ready), nil
+}
@@ -0,0 +1,143 @@
+/*
+	SPDX-License-Identifier: MIT
+*/
+
+package resources_test
+
+import (
+	"testing"
+
+	appsv1 "k8s.io/api/apps/v1"
+	v1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	"github.com/nukleros/operator-builder-tools/pkg/resources"
+)
+
+func TestReplicaSetResource_IsReady(t *testing.T) {
+	t.Parallel()
+
+	var replicas int32 = 2
+
+	type fields struct {
+		parent *appsv1.ReplicaSet
+	}
+
+	tests := []struct {
+		name    string
+		fields  fields
+		want    bool
+		wantErr bool
+	}{
+		{
+			name:    "replicaset should be ready",
+			want:    true,
+			wantErr: false,
+			fields: fields{
+				parent: &appsv1.ReplicaSet{
+					ObjectMeta: metav1.ObjectMeta{Name: "ready", Namespace: "ready", Generation: 1},
+					Spec:       appsv1.ReplicaSetSpec{Replicas: &replicas},
+					Status: appsv1.ReplicaSetStatus{
+						Replicas:           replicas,
+						ReadyReplicas:      replicas,
+						AvailableReplicas:  replicas,
+						ObservedGeneration: 1,
+					},
+				},
+			},
+		},
+		{
+			name:    "replicaset should not be ready (observed generation)",
+			want:    false,
+			wantErr: false,
+			fields: fields{
+				parent: &appsv1.ReplicaSet{
+					ObjectMeta: metav1.ObjectMeta{Name: "not-observed", Namespace: "not-observed", Generation: 2},
+					Spec:       appsv1.ReplicaSetSpec{Replicas: &replicas},
+					Status: appsv1.ReplicaSetStatus{
+						Replicas:           replicas,
+						ReadyReplicas:      replicas,
+						AvailableReplicas:  replicas,
+						ObservedGeneration: 1,
+					},
+				},
+			},
+		},
+		{
+			name:    "replicaset should not be ready (ready replicas)",
+			want:    false,
+			wantErr: false,
+			fields: fields{
+				parent: &appsv1.ReplicaSet{
+					ObjectMeta: metav1.ObjectMeta{Name: "not-ready", Namespace: "not-ready"},
+					Spec:       appsv1.ReplicaSetSpec{Replicas: &replicas},
+					Status: appsv1.ReplicaSetStatus{
+						Replicas:          replicas,
+						ReadyReplicas:     replicas - 1,
+						AvailableReplicas: replicas - 1,
+					},
+				},
+			},
+		},
+		{
+			name:    "replicaset should not be ready (available replicas)",
+			want:    false,
+			wantErr: false,
+			fields: fields{
+				parent: &appsv1.ReplicaSet{
+					ObjectMeta: metav1.ObjectMeta{Name: "not-available", Namespace: "not-available"},
+					Spec:       appsv1.ReplicaSetSpec{Replicas: &replicas},
+					Status: appsv1.ReplicaSetStatus{
+						Replicas:          replicas,
+						ReadyReplicas:     replicas,
+						AvailableReplicas: replicas - 1,
+					},
+				},
+			},
+		},
+		{
+			name:    "replicaset should not be ready (replica failure)",
+			want:    false,
+			wantErr: false,
+			fields: fields{
+				parent: &appsv1.ReplicaSet{
+					ObjectMeta: metav1.ObjectMeta{Name: "failure", Namespace: "failure"},
+					Spec:       appsv1.ReplicaSetSpec{Replicas: &replicas},
+					Status: appsv1.ReplicaSetStatus{
+						Conditions: []appsv1.ReplicaSetCondition{
+							{Type: appsv1.ReplicaSetReplicaFailure, Status: v1.ConditionTrue, Reason: "FailedCreate"},
+						},
+					},
+				},
+			},
+		},
+		{
+			name:    "replicaset should not be ready (empty)",
+			want:    false,
+			wantErr: false,
+			fields: fields{
+				parent: &appsv1.ReplicaSet{},
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		tt := tt
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			replicaSet := &resources.ReplicaSetResource{Object: *tt.fields.parent}
+
+			got, err := replicaSet.IsReady()
+			if (err != nil) != tt.wantErr {
+				t.Errorf("ReplicaSetResource.IsReady() error = %v, wantErr %v", err, tt.wantErr)
+
+				return
+			}
+
+			if got != tt.want {
+				t.Errorf("ReplicaSetResource.IsReady() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
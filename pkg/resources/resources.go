@@ -48,7 +48,7 @@ func ToTyped(destination, source client.Object) error {
 
 // getResourceCheckerFromReconciler gets a resource checker from an object.  This performs the resource
 // lookup within the cluster from a reconciler.
-func getResourceCheckerFromReconciler(r workload.Reconciler, req *workload.Request, resource client.Object) (resourceChecker, error) {
+func getResourceCheckerFromReconciler(r workload.Reconciler, req *workload.Request, resource client.Object) (ResourceChecker, error) {
 	if resource == nil {
 		return nil, fmt.Errorf("no object was found")
 	}
@@ -58,77 +58,106 @@ func getResourceCheckerFromReconciler(r workload.Reconciler, req *workload.Reque
 		return nil, err
 	}
 
-	switch resource.GetObjectKind().GroupVersionKind().Kind {
-	case MutatingWebhookConfigurationKind:
-		return NewMutatingWebhookConfigurationResource(r, req, clusterResource)
-	case ValidatingWebhookConfigurationKind:
-		return NewValidatingWebhookConfigurationResource(r, req, clusterResource)
-	default:
-		return getResourceChecker(clusterResource)
+	if clusterResource == nil {
+		return nil, fmt.Errorf("no object was found")
 	}
+
+	return defaultCheckerRegistry.reconcilerChecker(r, req, clusterResource)
 }
 
 // getResourceChecker gets a resource checker from an object.  This is only safe to assume that the
 // object being passed has already been retrieved from the cluster.
-func getResourceChecker(resource client.Object) (resourceChecker, error) {
+func getResourceChecker(resource client.Object) (ResourceChecker, error) {
 	if resource == nil {
 		return nil, fmt.Errorf("no object was found")
 	}
 
-	switch resource.GetObjectKind().GroupVersionKind().Kind {
-	case NamespaceKind:
-		return NewNamespaceResource(resource)
-	case CustomResourceDefinitionKind:
-		return NewCRDResource(resource)
-	case SecretKind:
-		return NewSecretResource(resource)
-	case ConfigMapKind:
-		return NewConfigMapResource(resource)
-	case DeploymentKind:
-		return NewDeploymentResource(resource)
-	case DaemonSetKind:
-		return NewDaemonSetResource(resource)
-	case StatefulSetKind:
-		return NewStatefulSetResource(resource)
-	case JobKind:
-		return NewJobResource(resource)
-	case ServiceKind:
-		return NewServiceResource(resource)
-	case EndpointsKind:
-		return NewEndpointsResource(resource)
-	case IssuerKind:
-		return NewIssuerResource(resource)
-	case ClusterIssuerKind:
-		return NewClusterIssuerResource(resource)
-	case CertificateKind:
-		return NewCertificateResource(resource)
-	default:
-		return NewUnknownResource(resource)
-	}
+	return defaultCheckerRegistry.checker(resource)
 }
 
+// ReadyOption is a pattern to allow customization of how the readiness of a resource is determined.
+type ReadyOption int
+
+const (
+	// ReadyOptionWithGenericReadiness determines the readiness of unknown resources without ready
+	// annotations from their status.observedGeneration and status.conditions fields.
+	ReadyOptionWithGenericReadiness ReadyOption = iota
+
+	// ReadyOptionWithPodDiagnostics lists the pods of a Deployment, StatefulSet, DaemonSet or Job which is
+	// not ready and includes a diagnosis of the problems with its pods in its readiness.  It only has an
+	// effect when the readiness is determined from a reconciler, which must be permitted to list pods.
+	ReadyOptionWithPodDiagnostics
+)
+
 // IsReadyFromReconciler returns whether a specific known resource is ready.  Always returns true for unknown resources
 // so that dependency checks will not fail and reconciliation of resources can happen with errors rather
-// than stopping entirely.  It takes in a client object and does the get on behalf of the caller.
-func IsReadyFromReconciler(r workload.Reconciler, req *workload.Request, resource client.Object) (bool, error) {
+// than stopping entirely, unless generic readiness is enabled.  It takes in a client object and does the get
+// on behalf of the caller.  Resources which have failed return an error.
+func IsReadyFromReconciler(r workload.Reconciler, req *workload.Request, resource client.Object, options ...ReadyOption) (bool, error) {
+	return readinessIsReady(GetReadinessFromReconciler(r, req, resource, options...))
+}
+
+// IsReady returns whether a specific known resource is ready.  Always returns true for unknown resources
+// so that dependency checks will not fail and reconciliation of resources can happen with errors rather
+// than stopping entirely, unless generic readiness is enabled.  Resources which have failed return an error.
+func IsReady(resource client.Object, options ...ReadyOption) (bool, error) {
+	return readinessIsReady(GetReadiness(resource, options...))
+}
+
+// GetReadinessFromReconciler returns the readiness of a specific resource along with the reason for it.  It
+// takes in a client object and does the get on behalf of the caller.
+func GetReadinessFromReconciler(
+	r workload.Reconciler,
+	req *workload.Request,
+	resource client.Object,
+	options ...ReadyOption,
+) (*Readiness, error) {
 	checker, err := getResourceCheckerFromReconciler(r, req, resource)
 	if err != nil {
-		return false, fmt.Errorf("unable to determine ready status for resource, %w", err)
+		return nil, fmt.Errorf("unable to determine ready status for resource, %w", err)
+	}
+
+	readiness, err := checkerReadiness(checker, options...)
+	if err != nil || !hasReadyOption(ReadyOptionWithPodDiagnostics, options...) {
+		return readiness, err
+	}
+
+	if err := diagnosePods(r, req, checker, readiness); err != nil {
+		return nil, fmt.Errorf("unable to diagnose pods for resource, %w", err)
 	}
 
-	return checker.IsReady()
+	return readiness, nil
 }
 
-// IsReady returns whether a specific known resource is ready.  Always returns true for unknown resources
-// so that dependency checks will not fail and reconciliation of resources can happen with errors rather
-// than stopping entirely.
-func IsReady(resource client.Object) (bool, error) {
+// GetReadiness returns the readiness of a specific resource along with the reason for it.  It is only safe
+// to assume that the object being passed has already been retrieved from the cluster.
+func GetReadiness(resource client.Object, options ...ReadyOption) (*Readiness, error) {
 	checker, err := getResourceChecker(resource)
 	if err != nil {
-		return false, fmt.Errorf("unable to determine ready status for resource, %w", err)
+		return nil, fmt.Errorf("unable to determine ready status for resource, %w", err)
+	}
+
+	return checkerReadiness(checker, options...)
+}
+
+// checkerReadiness returns the readiness of the resource of a checker, applying the requested options.
+func checkerReadiness(checker ResourceChecker, options ...ReadyOption) (*Readiness, error) {
+	if unknown, ok := checker.(*UnknownResource); ok && hasReadyOption(ReadyOptionWithGenericReadiness, options...) {
+		unknown.GenericReadiness = true
 	}
 
-	return checker.IsReady()
+	return readinessFor(checker)
+}
+
+// hasReadyOption returns true if a set of ready options has a given ready option.
+func hasReadyOption(option ReadyOption, options ...ReadyOption) bool {
+	for i := range options {
+		if options[i] == option {
+			return true
+		}
+	}
+
+	return false
 }
 
 // AreReady returns whether resources are ready.  All resources must be ready in order
@@ -146,21 +175,54 @@ func AreReady(resources ...client.Object) (bool, error) {
 
 // AreEqual determines if two resources are equal.
 func AreEqual(desired, actual client.Object) (bool, error) {
-	mergedResource, err := ToUnstructured(actual)
+	actualResource, err := ToUnstructured(actual)
 	if err != nil {
 		return false, err
 	}
 
-	actualResource, err := ToUnstructured(actual)
+	mergedResource, err := Merge(desired, actual)
 	if err != nil {
 		return false, err
 	}
 
-	desiredResource, err := ToUnstructured(desired)
+	// calculate the actual differences
+	diffOptions := []patch.CalculateOption{
+		reconciler.IgnoreManagedFields(),
+		patch.IgnoreStatusFields(),
+		patch.IgnoreVolumeClaimTemplateTypeMetaAndStatus(),
+		IgnorePDBSelector(),
+	}
+
+	diffResults, err := patch.DefaultPatchMaker.Calculate(
+		actualResource,
+		mergedResource,
+		diffOptions...,
+	)
 	if err != nil {
 		return false, err
 	}
 
+	return diffResults.IsEmpty(), nil
+}
+
+// Merge returns the actual resource with the fields of the desired resource merged into it.  This
+// represents the resource as it would exist in the cluster after an update.
+func Merge(desired, actual client.Object) (*unstructured.Unstructured, error) {
+	mergedResource, err := ToUnstructured(actual)
+	if err != nil {
+		return nil, err
+	}
+
+	actualResource, err := ToUnstructured(actual)
+	if err != nil {
+		return nil, err
+	}
+
+	desiredResource, err := ToUnstructured(desired)
+	if err != nil {
+		return nil, err
+	}
+
 	// ensure that resource versions and observed generation do not interfere
 	// with calculating equality
 	desiredResource.SetResourceVersion(actualResource.GetResourceVersion())
@@ -179,27 +241,10 @@ func AreEqual(desired, actual client.Object) (bool, error) {
 		mergo.WithOverride,
 		mergo.WithSliceDeepCopy,
 	); err != nil {
-		return false, err
-	}
-
-	// calculate the actual differences
-	diffOptions := []patch.CalculateOption{
-		reconciler.IgnoreManagedFields(),
-		patch.IgnoreStatusFields(),
-		patch.IgnoreVolumeClaimTemplateTypeMetaAndStatus(),
-		IgnorePDBSelector(),
-	}
-
-	diffResults, err := patch.DefaultPatchMaker.Calculate(
-		actualResource,
-		mergedResource,
-		diffOptions...,
-	)
-	if err != nil {
-		return false, err
+		return nil, err
 	}
 
-	return diffResults.IsEmpty(), nil
+	return mergedResource, nil
 }
 
 // AreDesired determines if an actual resource is in a desired state based on the state
@@ -86,5 +86,10 @@ func (service *ServiceResource) GetEndpoints(r workload.Reconciler, req *workloa
 		return nil, fmt.Errorf("unable to retrieve endpoints from service - %w", err)
 	}
 
+	// endpoints which do not exist yet are not ready
+	if endpoint == nil {
+		return &EndpointsResource{}, nil
+	}
+
 	return NewEndpointsResource(endpoint)
 }
@@ -5,7 +5,10 @@
 package resources
 
 import (
+	"fmt"
+
 	appsv1 "k8s.io/api/apps/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"sigs.k8s.io/controller-runtime/pkg/client"
 )
 
@@ -31,48 +34,98 @@ func NewStatefulSetResource(object client.Object) (*StatefulSetResource, error)
 	return &StatefulSetResource{Object: *statefulSet}, nil
 }
 
+// PodSelector returns the namespace and selector of the pods of a StatefulSet.
+func (statefulSet *StatefulSetResource) PodSelector() (string, *metav1.LabelSelector) {
+	return statefulSet.Object.Namespace, statefulSet.Object.Spec.Selector
+}
+
 // IsReady performs the logic to determine if a StatefulSet is ready.
 func (statefulSet *StatefulSetResource) IsReady() (bool, error) {
+	return readinessIsReady(statefulSet.Readiness())
+}
+
+// Readiness performs the logic to determine if a StatefulSet is ready along with the reason for it.  A
+// StatefulSet is ready once its rollout has completed, matching the semantics of kubectl rollout status.
+// Rollouts with a partition are complete once the pods at or above the partition have been updated, and
+// rollouts with the OnDelete strategy are not waited for as pods are only updated once they are deleted.
+func (statefulSet *StatefulSetResource) Readiness() (*Readiness, error) {
 	// if we have a name that is empty, we know we did not find the object
 	if statefulSet.Object.Name == "" {
-		return false, nil
+		return Progressing("NotFound", "statefulset not found"), nil
 	}
 
 	// rely on observed generation to give us a proper status
-	if statefulSet.Object.Generation != statefulSet.Object.Status.ObservedGeneration {
-		return false, nil
+	if statefulSet.Object.Status.ObservedGeneration == 0 ||
+		statefulSet.Object.Generation > statefulSet.Object.Status.ObservedGeneration {
+		return Progressing("ObservedGenerationOutdated", "statefulset spec has not been observed"), nil
 	}
 
-	// check for valid replicas
-	replicas := statefulSet.Object.Spec.Replicas
-	if replicas == nil {
-		return false, nil
+	status := statefulSet.Object.Status
+	replicas := statefulSet.replicas()
+
+	// check to see if replicas are ready
+	if status.ReadyReplicas < replicas {
+		return Progressing("ReplicasNotReady", fmt.Sprintf("%d/%d replicas ready", status.ReadyReplicas, replicas)), nil
 	}
 
-	// check to see if replicas have been updated
-	var needsUpdate int32
-	if statefulSet.Object.Spec.UpdateStrategy.RollingUpdate != nil &&
-		statefulSet.Object.Spec.UpdateStrategy.RollingUpdate.Partition != nil &&
-		*statefulSet.Object.Spec.UpdateStrategy.RollingUpdate.Partition > 0 {
-		needsUpdate -= *statefulSet.Object.Spec.UpdateStrategy.RollingUpdate.Partition
+	// check to see if replicas have been available for the minimum ready seconds
+	if statefulSet.Object.Spec.MinReadySeconds > 0 && status.AvailableReplicas < replicas {
+		return Progressing(
+			"ReplicasNotAvailable",
+			fmt.Sprintf("%d/%d replicas available", status.AvailableReplicas, replicas),
+		), nil
 	}
 
-	notUpdated := needsUpdate - statefulSet.Object.Status.UpdatedReplicas
-	if notUpdated > 0 {
-		return false, nil
+	// check to see if a scale down operation is complete
+	if notDeleted := status.Replicas - replicas; notDeleted > 0 {
+		return Progressing("ReplicasNotDeleted", fmt.Sprintf("%d replicas pending deletion", notDeleted)), nil
 	}
 
-	// check to see if replicas are available
-	notReady := *replicas - statefulSet.Object.Status.ReadyReplicas
-	if notReady > 0 {
-		return false, nil
+	ready := fmt.Sprintf("%d/%d replicas ready", status.ReadyReplicas, replicas)
+
+	// pods are not updated by the controller when using the OnDelete strategy
+	if statefulSet.Object.Spec.UpdateStrategy.Type == appsv1.OnDeleteStatefulSetStrategyType {
+		return Ready(ready), nil
 	}
 
-	// check to see if a scale down operation is complete
-	notDeleted := statefulSet.Object.Status.Replicas - *replicas
-	if notDeleted > 0 {
-		return false, nil
+	// check to see if the replicas at or above the partition have been updated
+	if partition := statefulSet.partition(); partition > 0 {
+		if needsUpdate := replicas - partition; status.UpdatedReplicas < needsUpdate {
+			return Progressing(
+				"PartitionedRolloutInProgress",
+				fmt.Sprintf("%d out of %d new replicas have been updated", status.UpdatedReplicas, needsUpdate),
+			), nil
+		}
+
+		return Ready(fmt.Sprintf("partitioned rollout complete: %s", ready)), nil
+	}
+
+	// check to see if all replicas are at the latest revision
+	if status.UpdateRevision != status.CurrentRevision {
+		return Progressing(
+			"RolloutInProgress",
+			fmt.Sprintf("%d out of %d replicas at revision %s", status.UpdatedReplicas, replicas, status.UpdateRevision),
+		), nil
+	}
+
+	return Ready(ready), nil
+}
+
+// replicas returns the desired number of replicas of the StatefulSet.
+func (statefulSet *StatefulSetResource) replicas() int32 {
+	if statefulSet.Object.Spec.Replicas == nil {
+		return 1
+	}
+
+	return *statefulSet.Object.Spec.Replicas
+}
+
+// partition returns the ordinal at or above which pods are updated during a rolling update.
+func (statefulSet *StatefulSetResource) partition() int32 {
+	rollingUpdate := statefulSet.Object.Spec.UpdateStrategy.RollingUpdate
+	if rollingUpdate == nil || rollingUpdate.Partition == nil {
+		return 0
 	}
 
-	return true, nil
+	return *rollingUpdate.Partition
 }
@@ -9,6 +9,7 @@ import (
 	"testing"
 
 	appsv1 "k8s.io/api/apps/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"sigs.k8s.io/controller-runtime/pkg/client"
 
 	"github.com/nukleros/operator-builder-tools/pkg/resources"
@@ -57,3 +58,174 @@ func TestNewStatefulSetResource(t *testing.T) {
 		})
 	}
 }
+
+func TestStatefulSetResource_Readiness(t *testing.T) {
+	t.Parallel()
+
+	var replicas, partition int32 = 3, 2
+
+	newStatefulSet := func(spec appsv1.StatefulSetSpec, status appsv1.StatefulSetStatus) *appsv1.StatefulSet {
+		spec.Replicas = &replicas
+		status.ObservedGeneration = 1
+
+		return &appsv1.StatefulSet{
+			ObjectMeta: metav1.ObjectMeta{Name: "statefulset", Namespace: "statefulset", Generation: 1},
+			Spec:       spec,
+			Status:     status,
+		}
+	}
+
+	type fields struct {
+		parent *appsv1.StatefulSet
+	}
+
+	tests := []struct {
+		name   string
+		fields fields
+		want   *resources.Readiness
+	}{
+		{
+			name: "statefulset should be ready",
+			fields: fields{
+				parent: newStatefulSet(appsv1.StatefulSetSpec{}, appsv1.StatefulSetStatus{
+					Replicas:        3,
+					ReadyReplicas:   3,
+					UpdatedReplicas: 3,
+					CurrentRevision: "statefulset-1",
+					UpdateRevision:  "statefulset-1",
+				}),
+			},
+			want: resources.Ready("3/3 replicas ready"),
+		},
+		{
+			name: "statefulset should not be ready (generation not observed)",
+			fields: fields{
+				parent: &appsv1.StatefulSet{
+					ObjectMeta: metav1.ObjectMeta{Name: "statefulset", Namespace: "statefulset", Generation: 2},
+					Status:     appsv1.StatefulSetStatus{ObservedGeneration: 1},
+				},
+			},
+			want: resources.Progressing("ObservedGenerationOutdated", "statefulset spec has not been observed"),
+		},
+		{
+			name: "statefulset should not be ready (replicas not ready)",
+			fields: fields{
+				parent: newStatefulSet(appsv1.StatefulSetSpec{}, appsv1.StatefulSetStatus{
+					Replicas:      3,
+					ReadyReplicas: 2,
+				}),
+			},
+			want: resources.Progressing("ReplicasNotReady", "2/3 replicas ready"),
+		},
+		{
+			name: "statefulset should not be ready (replicas not available for min ready seconds)",
+			fields: fields{
+				parent: newStatefulSet(appsv1.StatefulSetSpec{MinReadySeconds: 30}, appsv1.StatefulSetStatus{
+					Replicas:          3,
+					ReadyReplicas:     3,
+					AvailableReplicas: 1,
+				}),
+			},
+			want: resources.Progressing("ReplicasNotAvailable", "1/3 replicas available"),
+		},
+		{
+			name: "statefulset should not be ready (scale down in progress)",
+			fields: fields{
+				parent: newStatefulSet(appsv1.StatefulSetSpec{}, appsv1.StatefulSetStatus{
+					Replicas:      4,
+					ReadyReplicas: 4,
+				}),
+			},
+			want: resources.Progressing("ReplicasNotDeleted", "1 replicas pending deletion"),
+		},
+		{
+			name: "statefulset should not be ready (revisions differ)",
+			fields: fields{
+				parent: newStatefulSet(appsv1.StatefulSetSpec{}, appsv1.StatefulSetStatus{
+					Replicas:        3,
+					ReadyReplicas:   3,
+					UpdatedReplicas: 1,
+					CurrentRevision: "statefulset-1",
+					UpdateRevision:  "statefulset-2",
+				}),
+			},
+			want: resources.Progressing("RolloutInProgress", "1 out of 3 replicas at revision statefulset-2"),
+		},
+		{
+			name: "statefulset should not be ready (partitioned rollout in progress)",
+			fields: fields{
+				parent: newStatefulSet(appsv1.StatefulSetSpec{
+					UpdateStrategy: appsv1.StatefulSetUpdateStrategy{
+						Type:          appsv1.RollingUpdateStatefulSetStrategyType,
+						RollingUpdate: &appsv1.RollingUpdateStatefulSetStrategy{Partition: &partition},
+					},
+				}, appsv1.StatefulSetStatus{
+					Replicas:        3,
+					ReadyReplicas:   3,
+					CurrentRevision: "statefulset-1",
+					UpdateRevision:  "statefulset-2",
+				}),
+			},
+			want: resources.Progressing("PartitionedRolloutInProgress", "0 out of 1 new replicas have been updated"),
+		},
+		{
+			name: "statefulset should be ready (partitioned rollout complete)",
+			fields: fields{
+				parent: newStatefulSet(appsv1.StatefulSetSpec{
+					UpdateStrategy: appsv1.StatefulSetUpdateStrategy{
+						Type:          appsv1.RollingUpdateStatefulSetStrategyType,
+						RollingUpdate: &appsv1.RollingUpdateStatefulSetStrategy{Partition: &partition},
+					},
+				}, appsv1.StatefulSetStatus{
+					Replicas:        3,
+					ReadyReplicas:   3,
+					UpdatedReplicas: 1,
+					CurrentRevision: "statefulset-1",
+					UpdateRevision:  "statefulset-2",
+				}),
+			},
+			want: resources.Ready("partitioned rollout complete: 3/3 replicas ready"),
+		},
+		{
+			name: "statefulset should be ready (on delete strategy)",
+			fields: fields{
+				parent: newStatefulSet(appsv1.StatefulSetSpec{
+					UpdateStrategy: appsv1.StatefulSetUpdateStrategy{Type: appsv1.OnDeleteStatefulSetStrategyType},
+				}, appsv1.StatefulSetStatus{
+					Replicas:        3,
+					ReadyReplicas:   3,
+					CurrentRevision: "statefulset-1",
+					UpdateRevision:  "statefulset-2",
+				}),
+			},
+			want: resources.Ready("3/3 replicas ready"),
+		},
+		{
+			name: "statefulset should not be ready (empty)",
+			fields: fields{
+				parent: &appsv1.StatefulSet{},
+			},
+			want: resources.Progressing("NotFound", "statefulset not found"),
+		},
+	}
+
+	for _, tt := range tests {
+		tt := tt
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			statefulSet := &resources.StatefulSetResource{Object: *tt.fields.parent}
+
+			got, err := statefulSet.Readiness()
+			if err != nil {
+				t.Errorf("StatefulSetResource.Readiness() error = %v", err)
+
+				return
+			}
+
+			if !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("StatefulSetResource.Readiness() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
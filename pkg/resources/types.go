@@ -4,8 +4,24 @@
 
 package resources
 
-// resourceChecker is an interface which allows checking of a resource to see
+import metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+// ResourceChecker is an interface which allows checking of a resource to see
 // if it is in a ready state.
-type resourceChecker interface {
+type ResourceChecker interface {
 	IsReady() (bool, error)
 }
+
+// ReadinessChecker is an interface which allows checking of a resource to see
+// if it is in a ready state along with the reason that it is in that state.
+// It is optional to implement and takes precedence over IsReady.
+type ReadinessChecker interface {
+	Readiness() (*Readiness, error)
+}
+
+// PodSelector is an interface which allows a resource which manages pods to
+// expose the selector of its pods, so that its pods may be diagnosed when it
+// is not ready.  It is optional to implement.
+type PodSelector interface {
+	PodSelector() (namespace string, selector *metav1.LabelSelector)
+}
@@ -7,6 +7,7 @@ package resources
 import (
 	"fmt"
 	"strings"
+	"sync/atomic"
 
 	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
 	"k8s.io/client-go/util/jsonpath"
@@ -18,9 +19,33 @@ const (
 	ReadyValueAnnotation = "operator-builder.nukleros.io/ready-value"
 )
 
+// conditions which are evaluated by the generic readiness checks.
+const (
+	ReadyConditionType       = "Ready"
+	AvailableConditionType   = "Available"
+	ReconcilingConditionType = "Reconciling"
+	StalledConditionType     = "Stalled"
+)
+
+// genericReadiness determines if the generic readiness checks are enabled for all unknown resources.
+//
+//nolint:gochecknoglobals
+var genericReadiness atomic.Bool
+
+// SetGenericReadiness enables or disables the generic readiness checks for all unknown resources.  When
+// disabled, the generic readiness checks may still be enabled for individual checks with the
+// ReadyOptionWithGenericReadiness option.
+func SetGenericReadiness(enabled bool) {
+	genericReadiness.Store(enabled)
+}
+
 // UnknownResource represents an unknown object.
 type UnknownResource struct {
 	Object client.Object
+
+	// GenericReadiness determines if the readiness of the object is determined by the generic
+	// readiness checks when the object has no ready annotations.
+	GenericReadiness bool
 }
 
 // NewUnknownResource creates and returns a new UnknownResource.
@@ -30,9 +55,119 @@ func NewUnknownResource(object client.Object) (*UnknownResource, error) {
 
 // IsReady performs the logic to determine if an Unknown resource is ready.  It allows functionality
 // that will read a set of annotations that take in a field (in JSONpath format) and a value.  If
-// the specific field is equal to that value, then the resource is considered to be ready.
+// the specific field is equal to that value, then the resource is considered to be ready.  When the
+// annotations are not set and generic readiness is enabled, the resource is checked using the
+// generic readiness checks, otherwise it is always considered to be ready.  The ready and failed expression
+// annotations take precedence over all other checks.
 func (unknown *UnknownResource) IsReady() (bool, error) {
-	return isReadyFromAnnotations(unknown.Object)
+	return readinessIsReady(unknown.Readiness())
+}
+
+// Readiness performs the logic to determine if an Unknown resource is ready along with the reason
+// for it.  It follows the same rules as IsReady.
+func (unknown *UnknownResource) Readiness() (*Readiness, error) {
+	if hasExpressionAnnotations(unknown.Object) {
+		return readinessFromExpressions(unknown.Object)
+	}
+
+	if hasReadyAnnotations(unknown.Object) {
+		ready, err := isReadyFromAnnotations(unknown.Object)
+		if err != nil {
+			return nil, err
+		}
+
+		if !ready {
+			annotations := unknown.Object.GetAnnotations()
+
+			return Progressing("ReadyValueNotMatched", fmt.Sprintf("%s is not %s",
+				annotations[ReadyPathAnnotation], annotations[ReadyValueAnnotation])), nil
+		}
+
+		return Ready("ready value matched"), nil
+	}
+
+	if unknown.GenericReadiness || genericReadiness.Load() {
+		return readinessFromStatus(unknown.Object)
+	}
+
+	return Ready("resource exists"), nil
+}
+
+// hasReadyAnnotations determines if an object has both of the ready annotations set.
+func hasReadyAnnotations(object client.Object) bool {
+	if object == nil {
+		return false
+	}
+
+	annotations := object.GetAnnotations()
+
+	return annotations[ReadyPathAnnotation] != "" && annotations[ReadyValueAnnotation] != ""
+}
+
+// readinessFromStatus determines if an object is ready following the conventions used by kstatus.  An
+// object is not ready while its status.observedGeneration is behind its metadata.generation or while it
+// reports a Reconciling condition.  An object with a Stalled condition has failed.  Otherwise,
+// the object is ready unless it reports a Ready or Available condition which is not True.
+func readinessFromStatus(object client.Object) (*Readiness, error) {
+	if object == nil {
+		return Ready("resource has no status"), nil
+	}
+
+	asUnstructured, err := ToUnstructured(object)
+	if err != nil {
+		return nil, err
+	}
+
+	observedGeneration, found, err := unstructured.NestedInt64(asUnstructured.Object, "status", "observedGeneration")
+	if err != nil {
+		return nil, fmt.Errorf("unable to retrieve status.observedGeneration field, %w", err)
+	}
+
+	if found && observedGeneration < object.GetGeneration() {
+		return Progressing("ObservedGenerationOutdated", fmt.Sprintf("observed generation %d is behind generation %d",
+			observedGeneration, object.GetGeneration())), nil
+	}
+
+	conditions, _, err := unstructured.NestedSlice(asUnstructured.Object, "status", "conditions")
+	if err != nil {
+		return nil, fmt.Errorf("unable to retrieve status.conditions field, %w", err)
+	}
+
+	readiness := Ready("resource is current")
+
+	for _, condition := range conditions {
+		fields, ok := condition.(map[string]interface{})
+		if !ok {
+			continue
+		}
+
+		conditionType, _ := fields["type"].(string)
+		reason, _ := fields["reason"].(string)
+		message, _ := fields["message"].(string)
+
+		if reason == "" {
+			reason = conditionType
+		}
+
+		conditionTrue := fields["status"] == "True"
+
+		switch conditionType {
+		case StalledConditionType:
+			if conditionTrue {
+				return Failed(reason, message), nil
+			}
+		case ReconcilingConditionType:
+			if conditionTrue && readiness.IsReady() {
+				readiness = Progressing(reason, message)
+			}
+		case ReadyConditionType, AvailableConditionType:
+			if !conditionTrue && readiness.IsReady() {
+				readiness = Progressing(reason, message)
+			}
+		}
+	}
+
+	return readiness, nil
 }
 
 // isReadyFromAnnotations checks to see if the specific resource has annotations indicating that
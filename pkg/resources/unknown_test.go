@@ -0,0 +1,177 @@
+/*
+	SPDX-License-Identifier: MIT
+*/
+
+package resources_test
+
+import (
+	"testing"
+
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+
+	"github.com/nukleros/operator-builder-tools/pkg/resources"
+)
+
+func newUnknownObject(generation int64, annotations map[string]string, status map[string]interface{}) *unstructured.Unstructured {
+	object := &unstructured.Unstructured{
+		Object: map[string]interface{}{
+			"apiVersion": "example.io/v1",
+			"kind":       "Widget",
+			"metadata": map[string]interface{}{
+				"name":       "widget",
+				"generation": generation,
+			},
+		},
+	}
+
+	if annotations != nil {
+		object.SetAnnotations(annotations)
+	}
+
+	if status != nil {
+		object.Object["status"] = status
+	}
+
+	return object
+}
+
+func condition(conditionType, status string) map[string]interface{} {
+	return map[string]interface{}{
+		"type":    conditionType,
+		"status":  status,
+		"message": "condition " + conditionType + " is " + status,
+	}
+}
+
+func TestUnknownResource_IsReady(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name             string
+		object           *unstructured.Unstructured
+		genericReadiness bool
+		want             bool
+		wantErr          bool
+	}{
+		{
+			name: "resource without annotations is ready when generic readiness is disabled",
+			object: newUnknownObject(2, nil, map[string]interface{}{
+				"observedGeneration": int64(1),
+			}),
+			want: true,
+		},
+		{
+			name:             "resource without status is ready",
+			object:           newUnknownObject(1, nil, nil),
+			genericReadiness: true,
+			want:             true,
+		},
+		{
+			name: "resource with an outdated observed generation is not ready",
+			object: newUnknownObject(2, nil, map[string]interface{}{
+				"observedGeneration": int64(1),
+			}),
+			genericReadiness: true,
+			want:             false,
+		},
+		{
+			name: "resource with a current observed generation is ready",
+			object: newUnknownObject(2, nil, map[string]interface{}{
+				"observedGeneration": int64(2),
+			}),
+			genericReadiness: true,
+			want:             true,
+		},
+		{
+			name: "resource with a true ready condition is ready",
+			object: newUnknownObject(1, nil, map[string]interface{}{
+				"conditions": []interface{}{condition("Ready", "True")},
+			}),
+			genericReadiness: true,
+			want:             true,
+		},
+		{
+			name: "resource with a false ready condition is not ready",
+			object: newUnknownObject(1, nil, map[string]interface{}{
+				"conditions": []interface{}{condition("Ready", "False")},
+			}),
+			genericReadiness: true,
+			want:             false,
+		},
+		{
+			name: "resource with a false available condition is not ready",
+			object: newUnknownObject(1, nil, map[string]interface{}{
+				"conditions": []interface{}{condition("Available", "False")},
+			}),
+			genericReadiness: true,
+			want:             false,
+		},
+		{
+			name: "resource which is reconciling is not ready",
+			object: newUnknownObject(1, nil, map[string]interface{}{
+				"conditions": []interface{}{condition("Ready", "True"), condition("Reconciling", "True")},
+			}),
+			genericReadiness: true,
+			want:             false,
+		},
+		{
+			name: "resource which is stalled returns an error",
+			object: newUnknownObject(1, nil, map[string]interface{}{
+				"conditions": []interface{}{condition("Stalled", "True")},
+			}),
+			genericReadiness: true,
+			want:             false,
+			wantErr:          true,
+		},
+		{
+			name: "ready annotations take precedence over generic readiness",
+			object: newUnknownObject(1, map[string]string{
+				resources.ReadyPathAnnotation:  ".status.phase",
+				resources.ReadyValueAnnotation: "Running",
+			}, map[string]interface{}{
+				"phase":      "Running",
+				"conditions": []interface{}{condition("Ready", "False")},
+			}),
+			genericReadiness: true,
+			want:             true,
+		},
+	}
+
+	for _, tt := range tests {
+		tt := tt
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			unknown := &resources.UnknownResource{Object: tt.object, GenericReadiness: tt.genericReadiness}
+
+			got, err := unknown.IsReady()
+			if (err != nil) != tt.wantErr {
+				t.Errorf("UnknownResource.IsReady() error = %v, wantErr %v", err, tt.wantErr)
+
+				return
+			}
+
+			if got != tt.want {
+				t.Errorf("UnknownResource.IsReady() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestIsReady_WithGenericReadiness(t *testing.T) {
+	t.Parallel()
+
+	object := newUnknownObject(2, nil, map[string]interface{}{
+		"observedGeneration": int64(1),
+	})
+
+	ready, err := resources.IsReady(object)
+	if err != nil || !ready {
+		t.Errorf("IsReady() = %v, %v, want true, nil", ready, err)
+	}
+
+	ready, err = resources.IsReady(object, resources.ReadyOptionWithGenericReadiness)
+	if err != nil || ready {
+		t.Errorf("IsReady() = %v, %v, want false, nil", ready, err)
+	}
+}
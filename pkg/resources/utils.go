@@ -3,15 +3,37 @@
 package resources
 
 import (
+	goerrors "errors"
 	"fmt"
+	"reflect"
+	"strings"
 
 	"k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
 	"sigs.k8s.io/controller-runtime/pkg/client"
 
 	"github.com/nukleros/operator-builder-tools/pkg/controller/workload"
 )
 
+// ErrFieldOwnershipConflict is returned when a server-side apply request conflicts with fields
+// that are owned by another field manager.
+var ErrFieldOwnershipConflict = goerrors.New("field ownership conflict")
+
+// ErrImmutableField is returned when an update or apply request is rejected because it changes a field
+// which may not be changed once the resource has been created, such as the selector of a Deployment.
+var ErrImmutableField = goerrors.New("immutable field changed")
+
+// immutableFieldMessages are the messages used by the API server when rejecting a change to an
+// immutable field.
+//
+//nolint:gochecknoglobals
+var immutableFieldMessages = []string{
+	"field is immutable",
+	"may not change once set",
+	"updates to statefulset spec for fields other than",
+}
+
 // Create creates a resource.
 func Create(r workload.Reconciler, req *workload.Request, resource client.Object) error {
 	r.GetLogger().Info("creating resource", MessageFor(resource)...)
@@ -51,6 +73,197 @@ func Get(r workload.Reconciler, req *workload.Request, resource client.Object) (
 	return resourceStore, nil
 }
 
+// apiReader returns a reader which reads directly from the API server rather than from the cache of the
+// manager.  Reading a kind through the cache starts an informer for it which never syncs when the controller
+// is not permitted to list the kind, so lookups which are allowed to be forbidden must not use the cache.
+func apiReader(r workload.Reconciler) client.Reader {
+	return r.GetManager().GetAPIReader()
+}
+
+// Delete deletes a resource.  A resource which is already gone is not considered an error.
+func Delete(r workload.Reconciler, req *workload.Request, resource client.Object, options ...client.DeleteOption) error {
+	r.GetLogger().Info("deleting resource", MessageFor(resource)...)
+
+	if err := r.Delete(req.Context, resource, options...); err != nil {
+		if errors.IsNotFound(err) {
+			return nil
+		}
+
+		return fmt.Errorf("unable to delete resource; %w", err)
+	}
+
+	return nil
+}
+
+// Apply applies a resource using server-side apply with the field manager of the reconciler.  Conflicts with
+// other field managers are returned as an ErrFieldOwnershipConflict unless force is requested, in which case
+// ownership of the conflicting fields is taken.  Additional apply options, such as a dry run, may be requested.
+// The object as returned by the server is returned to the caller.
+//
+// Only the fields which are set on the resource are applied.  A typed resource is converted with all of its
+// fields, so fields which are unset, such as empty structs and null values, are removed before applying as
+// the field manager would otherwise take ownership of them and clear the values set by other managers.
+func Apply(
+	r workload.Reconciler,
+	req *workload.Request,
+	resource client.Object,
+	force bool,
+	applyOptions ...client.ApplyOption,
+) (*unstructured.Unstructured, error) {
+	applyResource, err := ToUnstructured(resource)
+	if err != nil {
+		return nil, fmt.Errorf("unable to convert resource for apply; %w", err)
+	}
+
+	// strip the fields which are either owned by the server or which are not allowed in
+	// an apply request
+	applyResource.SetResourceVersion("")
+	applyResource.SetManagedFields(nil)
+	unstructured.RemoveNestedField(applyResource.Object, "metadata", "creationTimestamp")
+	unstructured.RemoveNestedField(applyResource.Object, "status")
+
+	if _, isUnstructured := resource.(*unstructured.Unstructured); !isUnstructured {
+		removeUnsetFields(applyResource.Object, reflect.ValueOf(resource))
+	}
+
+	options := append([]client.ApplyOption{client.FieldOwner(r.GetFieldManager())}, applyOptions...)
+	if force {
+		options = append(options, client.ForceOwnership)
+	}
+
+	r.GetLogger().V(4).Info("applying resource", MessageFor(resource)...)
+
+	if err := r.Apply(req.Context, client.ApplyConfigurationFromUnstructured(applyResource), options...); err != nil {
+		if errors.IsConflict(err) && isFieldManagerConflict(err) {
+			return nil, fmt.Errorf("%w; %s", ErrFieldOwnershipConflict, err.Error())
+		}
+
+		if isImmutableFieldError(err) {
+			return nil, fmt.Errorf("%w; %s", ErrImmutableField, err.Error())
+		}
+
+		return nil, fmt.Errorf("unable to apply resource; %w", err)
+	}
+
+	return applyResource, nil
+}
+
+// removeUnsetFields removes the fields of an object, converted from a typed value, which are not set on the
+// typed value.  Null values and structs which are not pointers and hold their zero value are removed.  Other
+// zero values, such as a replica count of 0 or an empty struct which is referenced by a pointer, are kept as
+// they cannot be told apart from values which are intentionally set.
+func removeUnsetFields(object map[string]any, value reflect.Value) {
+	for value.Kind() == reflect.Pointer || value.Kind() == reflect.Interface {
+		if value.IsNil() {
+			return
+		}
+
+		value = value.Elem()
+	}
+
+	if value.Kind() != reflect.Struct {
+		return
+	}
+
+	for i := 0; i < value.NumField(); i++ {
+		field := value.Type().Field(i)
+		if !field.IsExported() {
+			continue
+		}
+
+		name, options, _ := strings.Cut(field.Tag.Get("json"), ",")
+
+		// inlined structs, such as the type metadata, store their fields on the same object
+		if name == "" && (field.Anonymous || options == "inline") {
+			removeUnsetFields(object, value.Field(i))
+
+			continue
+		}
+
+		entry, ok := object[name]
+		if !ok || name == "-" {
+			continue
+		}
+
+		fieldValue := value.Field(i)
+
+		if entry == nil || (fieldValue.Kind() == reflect.Struct && fieldValue.IsZero()) {
+			delete(object, name)
+
+			continue
+		}
+
+		removeUnsetNestedFields(entry, fieldValue)
+	}
+}
+
+// removeUnsetNestedFields removes the unset fields of the objects nested within a converted field.
+func removeUnsetNestedFields(entry any, value reflect.Value) {
+	for value.Kind() == reflect.Pointer {
+		if value.IsNil() {
+			return
+		}
+
+		value = value.Elem()
+	}
+
+	switch nested := entry.(type) {
+	case map[string]any:
+		removeUnsetFields(nested, value)
+	case []any:
+		if value.Kind() != reflect.Slice || value.Len() != len(nested) {
+			return
+		}
+
+		for i := range nested {
+			removeUnsetNestedFields(nested[i], value.Index(i))
+		}
+	}
+}
+
+// isFieldManagerConflict determines if a conflict error was caused by another field manager owning
+// the fields that were applied rather than by an optimistic lock.
+func isFieldManagerConflict(err error) bool {
+	var statusErr errors.APIStatus
+	if !goerrors.As(err, &statusErr) || statusErr.Status().Details == nil {
+		return false
+	}
+
+	for _, cause := range statusErr.Status().Details.Causes {
+		if cause.Type == metav1.CauseTypeFieldManagerConflict {
+			return true
+		}
+	}
+
+	return false
+}
+
+// isImmutableFieldError determines if an invalid error was caused by a change to an immutable field.
+func isImmutableFieldError(err error) bool {
+	if !errors.IsInvalid(err) {
+		return false
+	}
+
+	messages := []string{err.Error()}
+
+	var statusErr errors.APIStatus
+	if goerrors.As(err, &statusErr) && statusErr.Status().Details != nil {
+		for _, cause := range statusErr.Status().Details.Causes {
+			messages = append(messages, cause.Message)
+		}
+	}
+
+	for _, message := range messages {
+		for _, immutableMessage := range immutableFieldMessages {
+			if strings.Contains(message, immutableMessage) {
+				return true
+			}
+		}
+	}
+
+	return false
+}
+
 // Update updates a resource.
 func Update(r workload.Reconciler, req *workload.Request, newResource, oldResource client.Object) error {
 	// return immediately if we found an error or we do not need an update
@@ -71,6 +284,10 @@ func Update(r workload.Reconciler, req *workload.Request, newResource, oldResour
 		client.Merge,
 		&client.PatchOptions{FieldManager: r.GetFieldManager()},
 	); err != nil {
+		if isImmutableFieldError(err) {
+			return fmt.Errorf("%w; %s", ErrImmutableField, err.Error())
+		}
+
 		return fmt.Errorf("unable to update resource; %w", err)
 	}
 
@@ -91,6 +308,12 @@ func NeedsUpdate(r workload.Reconciler, desired, actual client.Object) (bool, er
 		return false, nil
 	}
 
+	return !SkipsUpdate(r, desired), nil
+}
+
+// SkipsUpdate determines if updates to a resource which already exists are always skipped.  Custom resource
+// definitions are never updated, whether they are updated directly or with server-side apply.
+func SkipsUpdate(r workload.Reconciler, desired client.Object) bool {
 	// always skip custom resource updates as they are sensitive to modification
 	// e.g. resources provisioned by the resource definition would not
 	// understand the update to a spec
@@ -102,10 +325,10 @@ func NeedsUpdate(r workload.Reconciler, desired, actual client.Object) (bool, er
 		r.GetLogger().V(4).Info("skipping update", "CustomResourceDefinition", desired.GetName())
 		r.GetLogger().V(7).Info(messageVerbose, "CustomResourceDefinition", desired.GetName())
 
-		return false, nil
+		return true
 	}
 
-	return true, nil
+	return false
 }
 
 // MessageFor returns a CRUD message for a particular resource.  It sets an even number of key/value pairs
@@ -0,0 +1,117 @@
+/*
+	SPDX-License-Identifier: MIT
+*/
+
+package resources_test
+
+import (
+	"context"
+	"encoding/json"
+	"testing"
+
+	"github.com/go-logr/logr"
+	appsv1 "k8s.io/api/apps/v1"
+	v1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	"github.com/nukleros/operator-builder-tools/pkg/controller/workload"
+	"github.com/nukleros/operator-builder-tools/pkg/resources"
+)
+
+// applyReconciler is a reconciler which records the last object that was applied.
+type applyReconciler struct {
+	workload.Reconciler
+
+	applied map[string]any
+}
+
+func (r *applyReconciler) GetLogger() logr.Logger {
+	return logr.Discard()
+}
+
+func (r *applyReconciler) GetFieldManager() string {
+	return "test"
+}
+
+func (r *applyReconciler) Apply(_ context.Context, obj runtime.ApplyConfiguration, _ ...client.ApplyOption) error {
+	applied, err := json.Marshal(obj)
+	if err != nil {
+		return err
+	}
+
+	return json.Unmarshal(applied, &r.applied)
+}
+
+func TestApply(t *testing.T) {
+	t.Parallel()
+
+	var replicas int32
+
+	deployment := &appsv1.Deployment{
+		TypeMeta:   metav1.TypeMeta{Kind: resources.DeploymentKind, APIVersion: resources.DeploymentVersion},
+		ObjectMeta: metav1.ObjectMeta{Name: "deployment", Namespace: "default", ResourceVersion: "1"},
+		Spec: appsv1.DeploymentSpec{
+			Replicas: &replicas,
+			Template: v1.PodTemplateSpec{
+				Spec: v1.PodSpec{
+					Containers: []v1.Container{{Name: "app", Image: "app:latest"}},
+					Volumes: []v1.Volume{
+						{Name: "scratch", VolumeSource: v1.VolumeSource{EmptyDir: &v1.EmptyDirVolumeSource{}}},
+					},
+				},
+			},
+		},
+	}
+
+	tests := []struct {
+		name    string
+		path    []string
+		wantSet bool
+	}{
+		{name: "status is removed", path: []string{"status"}},
+		{name: "creation timestamp is removed", path: []string{"metadata", "creationTimestamp"}},
+		{name: "resource version is removed", path: []string{"metadata", "resourceVersion"}},
+		{name: "unset struct is removed", path: []string{"spec", "strategy"}},
+		{name: "unset nested struct is removed", path: []string{"spec", "template", "metadata"}},
+		{name: "replica count of zero is kept", path: []string{"spec", "replicas"}, wantSet: true},
+		{name: "name is kept", path: []string{"metadata", "name"}, wantSet: true},
+	}
+
+	r := &applyReconciler{}
+
+	if _, err := resources.Apply(r, &workload.Request{Context: context.Background()}, deployment, false); err != nil {
+		t.Fatalf("Apply() error = %v", err)
+	}
+
+	containers, _, _ := unstructured.NestedSlice(r.applied, "spec", "template", "spec", "containers")
+	if len(containers) != 1 {
+		t.Fatalf("Apply() containers = %v, want 1 container", containers)
+	}
+
+	if _, found := containers[0].(map[string]any)["resources"]; found {
+		t.Errorf("Apply() container resources = %v, want unset", containers[0])
+	}
+
+	volumes, _, _ := unstructured.NestedSlice(r.applied, "spec", "template", "spec", "volumes")
+	if len(volumes) != 1 {
+		t.Fatalf("Apply() volumes = %v, want 1 volume", volumes)
+	}
+
+	if _, found := volumes[0].(map[string]any)["emptyDir"]; !found {
+		t.Errorf("Apply() volume = %v, want emptyDir to be kept", volumes[0])
+	}
+
+	for _, tt := range tests {
+		tt := tt
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			if _, found, _ := unstructured.NestedFieldNoCopy(r.applied, tt.path...); found != tt.wantSet {
+				t.Errorf("Apply() field %v set = %v, want %v", tt.path, found, tt.wantSet)
+			}
+		})
+	}
+}
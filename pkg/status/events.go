@@ -15,32 +15,40 @@ const (
 	Created
 	Updated
 	Deleted
+	Recreated
+	Unhealthy
 )
 
 const (
-	UnknownString = "Unknown"
-	CreatedString = "Created"
-	UpdatedString = "Updated"
-	DeletedString = "Deleted"
+	UnknownString   = "Unknown"
+	CreatedString   = "Created"
+	UpdatedString   = "Updated"
+	DeletedString   = "Deleted"
+	RecreatedString = "Recreated"
+	UnhealthyString = "Unhealthy"
 )
 
 // String returns the string value of an event.
 func (event Event) String() string {
 	return map[Event]string{
-		Unknown: UnknownString,
-		Created: CreatedString,
-		Updated: UpdatedString,
-		Deleted: DeletedString,
+		Unknown:   UnknownString,
+		Created:   CreatedString,
+		Updated:   UpdatedString,
+		Deleted:   DeletedString,
+		Recreated: RecreatedString,
+		Unhealthy: UnhealthyString,
 	}[event]
 }
 
 // Type returns the type of event.
 func (event Event) Type() string {
 	return map[Event]string{
-		Unknown: UnknownString,
-		Created: corev1.EventTypeNormal,
-		Updated: corev1.EventTypeNormal,
-		Deleted: corev1.EventTypeNormal,
+		Unknown:   UnknownString,
+		Created:   corev1.EventTypeNormal,
+		Updated:   corev1.EventTypeNormal,
+		Deleted:   corev1.EventTypeNormal,
+		Recreated: corev1.EventTypeNormal,
+		Unhealthy: corev1.EventTypeWarning,
 	}[event]
 }
 
@@ -62,6 +70,23 @@ func (event Event) RegisterAction(recorder events.EventRecorder, child, parent c
 	)
 }
 
+// RegisterMessage registers an event along with a message which describes the event, such as the reason
+// that a child resource is unhealthy.  The event is registered against the parent object.
+func (event Event) RegisterMessage(recorder events.EventRecorder, child, parent client.Object, message string) {
+	recorder.Eventf(
+		parent,
+		child,
+		event.Type(),
+		event.String(),
+		event.String(),
+		"%s child resource '%s' managed by parent resource '%s': %s",
+		event.String(),
+		getMessageString(child),
+		getMessageString(parent),
+		message,
+	)
+}
+
 // getMessageString gets the message string for an object.  The message string is the message that is
 // displayed when a resource is acted upon.
 func getMessageString(object client.Object) string {
@@ -50,6 +50,15 @@ func GetPendingCondition(name string) PhaseCondition {
 	}
 }
 
+// GetPendingConditionWithError defines the pending condition for the phase including the error
+// which describes why the phase is pending.
+func GetPendingConditionWithError(name string, err error) PhaseCondition {
+	condition := GetPendingCondition(name)
+	condition.Message = "Pending Execution of Phase; " + err.Error()
+
+	return condition
+}
+
 // GetFailCondition defines the fail condition for the phase.
 func GetFailCondition(name string, err error) PhaseCondition {
 	return PhaseCondition{
@@ -8,6 +8,16 @@ import (
 	"sigs.k8s.io/controller-runtime/pkg/client"
 )
 
+// ResourceState defines the readiness state of a child resource.
+// +kubebuilder:validation:Enum=Ready;Progressing;Failed
+type ResourceState string
+
+const (
+	ResourceStateReady       ResourceState = "Ready"
+	ResourceStateProgressing ResourceState = "Progressing"
+	ResourceStateFailed      ResourceState = "Failed"
+)
+
 // ChildResource is the resource and its condition as stored on the workload custom resource's status field.
 type ChildResource struct {
 	// Group defines the API Group of the resource.
@@ -39,6 +49,12 @@ type ChildResourceCondition struct {
 
 	// Message defines a helpful message from the resource phase.
 	Message string `json:"message,omitempty"`
+
+	// State defines the readiness state of this object once it has been created.
+	State ResourceState `json:"state,omitempty"`
+
+	// Reason defines a machine-readable reason for the readiness state of this object.
+	Reason string `json:"reason,omitempty"`
 }
 
 // ToCommonResource converts a client.Object into a common API resource.
@@ -80,3 +96,25 @@ func GetFailResourceCondition(err error) ChildResourceCondition {
 		Message:      "unable to proceed with resource creation " + err.Error(),
 	}
 }
+
+// GetConflictResourceCondition defines the condition for a resource whose fields are owned by
+// another field manager.
+func GetConflictResourceCondition(err error) ChildResourceCondition {
+	return ChildResourceCondition{
+		Created:      false,
+		LastModified: time.Now().UTC().String(),
+		Message:      "unable to proceed with resource apply " + err.Error(),
+	}
+}
+
+// GetReadinessResourceCondition defines the condition for a resource which has been created, including
+// the readiness state of the resource.
+func GetReadinessResourceCondition(state ResourceState, reason, message string) ChildResourceCondition {
+	return ChildResourceCondition{
+		Created:      true,
+		LastModified: time.Now().UTC().String(),
+		Message:      message,
+		State:        state,
+		Reason:       reason,
+	}
+}